@@ -0,0 +1,35 @@
+package version
+
+import "time"
+
+// Automatic dating of entries added with AddChange or History.Add.
+var (
+	// AutoDate, if true, stamps each Change added without a Date with the
+	// current time (see Now), so that release scripts need not format dates.
+	AutoDate bool
+
+	// AutoDateFormat defines the format of the date stamped by AutoDate. The
+	// date is written in UTC.
+	AutoDateFormat = time.RFC3339
+)
+
+// stamp sets the Date of Change c to the current time if AutoDate is enabled
+// and c has no Date.
+func stamp(c *Change) {
+	if AutoDate && "" == c.Date {
+		c.Date = Now().UTC().Format(AutoDateFormat)
+	}
+}
+
+// AddChange validates Change c and appends it to ChangeLog, updating the
+// package version (see String) to that of c. The version of c must have higher
+// precedence than every entry in ChangeLog of the same Module, including those
+// marked Yanked, and, if c is not scoped to a module, higher than the current
+// package version. Entries added with AddChange therefore keep ChangeLog
+// ordered from oldest to newest.
+// If AutoDate is enabled and c has no Date, it is dated with the current time.
+// Returns an error, without modifying ChangeLog or the package version, if any
+// version string is invalid or the version of c is not the greatest.
+func AddChange(c Change) error {
+	return Default.AddChange(c)
+}
@@ -0,0 +1,74 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestAddChange(t *testing.T) {
+	defer func(log version.History, v version.Semver) {
+		version.ChangeLog, version.Version = log, v
+	}(version.ChangeLog, version.Version)
+	version.ChangeLog = version.History{{Version: "1.0.0"}, {Version: "1.1.0", Yanked: true}}
+	version.Version = version.Semver{}
+
+	for _, v := range []string{"1.0.1", "1.1.0", "1.1.0+build", "1.02.0"} {
+		if err := version.AddChange(version.Change{Version: v}); nil == err {
+			t.Errorf("AddChange(%q) did not return an error", v)
+		}
+	}
+	if err := version.AddChange(version.Change{Version: "1.2.0"}); nil != err {
+		t.Fatal(err)
+	}
+	if got := version.String(); "1.2.0" != got {
+		t.Errorf("String() = %q, want 1.2.0", got)
+	}
+
+	// modules are ordered independently of the package version
+	if err := version.AddChange(version.Change{Module: "sub", Version: "0.1.0"}); nil != err {
+		t.Error(err)
+	}
+	if got := version.String(); "1.2.0" != got {
+		t.Errorf("String() after module change = %q, want 1.2.0", got)
+	}
+	if err := version.AddChange(version.Change{Version: "1.2.1"}); nil != err {
+		t.Error(err)
+	}
+
+	// an explicitly set package version is updated
+	version.Set("2.0.0")
+	if err := version.AddChange(version.Change{Version: "1.3.0"}); nil == err {
+		t.Error("AddChange accepted a version lower than the package version")
+	}
+	if err := version.AddChange(version.Change{Version: "2.1.0"}); nil != err {
+		t.Fatal(err)
+	}
+	if got := version.String(); "2.1.0" != got {
+		t.Errorf("String() = %q, want 2.1.0", got)
+	}
+	if n := len(version.ChangeLog); 6 != n {
+		t.Errorf("len(ChangeLog) = %d, want 6", n)
+	}
+}
+
+func TestAutoDate(t *testing.T) {
+	defer func(auto bool, now func() time.Time) {
+		version.AutoDate, version.Now = auto, now
+	}(version.AutoDate, version.Now)
+	version.Now = func() time.Time {
+		return time.Date(2020, 3, 9, 12, 45, 23, 0, time.FixedZone("EST", -5*60*60))
+	}
+
+	var h version.History
+	h.Add(version.Change{Version: "1.0.0"})
+	version.AutoDate = true
+	h.Add(version.Change{Version: "1.1.0"})
+	h.Add(version.Change{Version: "1.2.0", Date: "2021-01-01"})
+	for i, want := range []string{"", "2020-03-09T17:45:23Z", "2021-01-01"} {
+		if got := h[i].Date; got != want {
+			t.Errorf("h[%d].Date = %q, want %q", i, got, want)
+		}
+	}
+}
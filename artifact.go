@@ -0,0 +1,39 @@
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Artifact describes a file distributed with a release, such as an archive or
+// installer for a particular platform.
+type Artifact struct {
+	Name     string `json:"name"`               // file name
+	Platform string `json:"platform,omitempty"` // target platform, e.g. "linux/amd64"
+	SHA256   string `json:"sha256,omitempty"`   // hex-encoded SHA-256 checksum
+	URL      string `json:"url,omitempty"`      // download location
+}
+
+// Verify reads the entire content of r and returns an error if its SHA-256
+// checksum does not match that of Artifact a.
+func (a Artifact) Verify(r io.Reader) error {
+	if "" == a.SHA256 {
+		return fmt.Errorf("verify %s: no checksum", a.Name)
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, r); nil != err {
+		return fmt.Errorf("verify %s: %w", a.Name, err)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, a.SHA256) {
+		return fmt.Errorf("verify %s: %w: got %s, want %s",
+			a.Name, ErrChecksum, sum, a.SHA256)
+	}
+	return nil
+}
+
+// ErrChecksum is returned by Artifact.Verify when checksums do not match.
+var ErrChecksum = errors.New("checksum mismatch")
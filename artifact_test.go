@@ -0,0 +1,47 @@
+package version_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestArtifactVerify(t *testing.T) {
+	a := version.Artifact{
+		Name:   "hello.txt",
+		SHA256: "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824",
+	}
+	if err := a.Verify(strings.NewReader("hello")); nil != err {
+		t.Errorf("Verify(hello) = %v", err)
+	}
+	if err := a.Verify(strings.NewReader("hullo")); !errors.Is(err, version.ErrChecksum) {
+		t.Errorf("Verify(hullo) = %v, want %v", err, version.ErrChecksum)
+	}
+}
+
+func ExampleArtifact() {
+	c := version.Change{
+		Version:     "1.0.0",
+		Description: []string{"initial release"},
+		Artifacts: []version.Artifact{{
+			Name:     "mypkg_1.0.0_linux_amd64.tar.gz",
+			Platform: "linux/amd64",
+			SHA256:   "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			URL:      "https://example.com/mypkg_1.0.0_linux_amd64.tar.gz",
+		}},
+	}
+	fmt.Print(c.Layout(version.RenderOptions{Width: 40, Rule: '-', Margin: 1, Indent: 2, Hang: 2}))
+
+	// Output:
+	// ----------------------------------------
+	//  version 1.0.0
+	// ----------------------------------------
+	//   initial release
+	//   Artifacts:
+	//     mypkg_1.0.0_linux_amd64.tar.gz (linux/amd64)
+	//       sha256 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824
+	//       https://example.com/mypkg_1.0.0_linux_amd64.tar.gz
+}
@@ -0,0 +1,70 @@
+package version
+
+import (
+	"strings"
+)
+
+// DefaultArtifactName is the template used by FormatArtifactName if none is
+// given, producing names such as "myapp_1.4.2_linux_amd64".
+const DefaultArtifactName = "{{.Package}}_{{.Version}}_{{.OS}}_{{.Arch}}"
+
+// ArtifactName contains the fields available to the template given to
+// FormatArtifactName. Each field is sanitized for use in file names and URLs.
+type ArtifactName struct {
+	Package string // package name of the last ChangeLog entry without a Module
+	Version string // package version (see String)
+	Commit  string // source revision (see Commit)
+	OS      string // target operating system, e.g. "linux"
+	Arch    string // target architecture, e.g. "amd64"
+}
+
+// FormatArtifactName returns the name of a release artifact for the given
+// target operating system and architecture, formatted with the given template
+// (see NewTemplate) executed with an ArtifactName; for example:
+//
+//	"{{.Package}}_{{.Version}}_{{.OS}}_{{.Arch}}.tar.gz"
+//
+// produces "myapp_1.4.2_linux_amd64.tar.gz". DefaultArtifactName is used if the
+// template is empty. Characters other than ASCII letters, digits, ".", and "-"
+// in each field (such as the "+" preceding build metadata) are replaced with
+// "_", so the name is safe on all common file systems.
+// Returns an error if the package version is invalid or the template fails.
+func FormatArtifactName(template, os, arch string) (string, error) {
+	if "" == template {
+		template = DefaultArtifactName
+	}
+	t, err := NewTemplate("artifact", template)
+	if nil != err {
+		return "", err
+	}
+	ver, err := versionString()
+	if nil != err {
+		return "", err
+	}
+	name := ArtifactName{
+		Version: sanitize(ver),
+		Commit:  sanitize(Commit),
+		OS:      sanitize(os),
+		Arch:    sanitize(arch),
+	}
+	if c := Default.latest(); nil != c {
+		name.Package = sanitize(c.Package)
+	}
+	b := strings.Builder{}
+	if err := t.Execute(&b, name); nil != err {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// sanitize returns s with each character other than ASCII letters, digits, ".",
+// and "-" replaced with "_".
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' ||
+			'.' == r || '-' == r {
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,23 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleFormatArtifactName() {
+	defer func(v version.Semver) { version.Version = v }(version.Version)
+	version.Set("1.4.2-rc.1+build.5")
+
+	for _, target := range [][2]string{{"linux", "amd64"}, {"windows", "arm64"}} {
+		name, _ := version.FormatArtifactName(
+			`{{.Package}}_{{.Version}}_{{.OS}}_{{.Arch}}{{if eq .OS "windows"}}.zip{{else}}.tar.gz{{end}}`,
+			target[0], target[1])
+		fmt.Println(name)
+	}
+
+	// Output:
+	// mypkg_1.4.2-rc.1_build.5_linux_amd64.tar.gz
+	// mypkg_1.4.2-rc.1_build.5_windows_arm64.zip
+}
@@ -0,0 +1,44 @@
+package version
+
+import (
+	"sort"
+	"strings"
+)
+
+// Contributors returns the unique names of all Authors of every entry in
+// ChangeLog, sorted alphabetically without regard to case.
+func Contributors() []string {
+	return contributors(ChangeLog)
+}
+
+// ContributorsSince returns the unique names of all Authors of each entry in
+// ChangeLog whose version has higher precedence than the given version, sorted
+// alphabetically without regard to case. Use it to credit the contributors of
+// an upcoming or recent release.
+// It panics if any of the version strings are invalid.
+func ContributorsSince(version string) []string {
+	var since []Change
+	for _, c := range ChangeLog {
+		if compareVersions(c.Version, version) > 0 {
+			since = append(since, c)
+		}
+	}
+	return contributors(since)
+}
+
+func contributors(log []Change) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, c := range log {
+		for _, a := range c.Authors {
+			if a = strings.TrimSpace(a); "" != a && !seen[a] {
+				seen[a] = true
+				names = append(names, a)
+			}
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+	return names
+}
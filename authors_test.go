@@ -0,0 +1,33 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleContributors() {
+	log := version.ChangeLog
+	defer func() { version.ChangeLog = log }()
+
+	version.ChangeLog = []version.Change{
+		{Version: "0.1.0", Authors: []string{"ardnew"}},
+		{Version: "0.2.0", Authors: []string{"Sweet", "ardnew"}},
+		{Version: "0.3.0", Authors: []string{"dude"}, Description: []string{"add feature: Dude"}},
+	}
+	fmt.Println(version.Contributors())
+	fmt.Println(version.ContributorsSince("0.1.0"))
+
+	opts := version.DefaultRenderOptions
+	opts.Width = 40
+	fmt.Print(version.ChangeLog[2].Layout(opts))
+
+	// Output:
+	// [ardnew dude Sweet]
+	// [ardnew dude Sweet]
+	// ――――――――――――――――――――――――――――――――――――――――
+	//  version 0.3.0
+	// ――――――――――――――――――――――――――――――――――――――――
+	//   add feature: Dude
+	//   Authors: dude
+}
@@ -0,0 +1,31 @@
+package version
+
+// IsBreaking returns true if and only if Change c is marked Breaking, or any
+// line of its description begins with a breaking change marker such as
+// "BREAKING CHANGE:" or "BREAKING:".
+func (c *Change) IsBreaking() bool {
+	if c.Breaking {
+		return true
+	}
+	for _, line := range c.Description {
+		if isBreaking(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakingChangesSince returns each breaking entry in ChangeLog (see
+// IsBreaking) whose version has higher precedence than the given version, in
+// ChangeLog order. Upgrade tooling can use it to warn users before they move
+// from the given version to the current version.
+// It panics if any of the version strings are invalid.
+func BreakingChangesSince(version string) []Change {
+	var changes []Change
+	for _, c := range ChangeLog {
+		if compareVersions(c.Version, version) > 0 && c.IsBreaking() {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
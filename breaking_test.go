@@ -0,0 +1,33 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleBreakingChangesSince() {
+	log := version.ChangeLog
+	defer func() { version.ChangeLog = log }()
+
+	version.ChangeLog = []version.Change{
+		{Version: "1.0.0", Breaking: true, Description: []string{"initial release"}},
+		{Version: "1.1.0", Description: []string{"add feature: Dude"}},
+		{Version: "2.0.0", Description: []string{"BREAKING CHANGE: remove Dude"}},
+		{Version: "3.0.0-rc.1", Title: "Red Label", Breaking: true},
+	}
+	for _, c := range version.BreakingChangesSince("1.0.0") {
+		fmt.Println(c.Version)
+	}
+
+	opts := version.DefaultRenderOptions
+	opts.Width = 50
+	fmt.Print(version.ChangeLog[3].Layout(opts))
+
+	// Output:
+	// 2.0.0
+	// 3.0.0-rc.1
+	// ――――――――――――――――――――――――――――――――――――――――――――――――――
+	//  version 3.0.0-rc.1 [BREAKING] - "Red Label"
+	// ――――――――――――――――――――――――――――――――――――――――――――――――――
+}
@@ -0,0 +1,26 @@
+package version
+
+import "time"
+
+// Build details that cannot be determined from source are typically injected at
+// link time, for example:
+//
+//	go build -ldflags "-X github.com/ardnew/version.Commit=$(git rev-parse HEAD)"
+var (
+	// Commit identifies the source revision (e.g., git commit hash) from which
+	// the executable was built.
+	Commit string
+
+	// BuildDate records the date-time at which the executable was built. Any of
+	// the formats recognized by ParseDate may be used.
+	BuildDate string
+
+	// TreeState records the state of the source tree from which the executable
+	// was built: "clean" if it had no uncommitted changes, or "dirty".
+	TreeState string
+)
+
+// Now returns the current time. It is used wherever this package needs the
+// current time, such as when writing relative dates, and may be replaced (e.g.,
+// in tests) to make output deterministic.
+var Now = time.Now
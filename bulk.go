@@ -0,0 +1,74 @@
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// parallel calls fn once for each index in [0, n), distributing the calls
+// across GOMAXPROCS goroutines, and returns once every call has returned.
+func parallel(n int, fn func(i int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < n; i += workers {
+				fn(i)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
+
+// ParseAll parses each of the given version strings concurrently, according
+// to VersionPattern, and returns the components and parse error of each, at the
+// same index as its version string. The error of each valid version is nil, and
+// errs is nil if every version is valid.
+func ParseAll(versions []string) (semvers []Semver, errs []error) {
+	cfg := globalConfig()
+	semvers = make([]Semver, len(versions))
+	all := make([]error, len(versions))
+	parallel(len(versions), func(i int) {
+		semvers[i], all[i] = cfg.Parse(versions[i])
+	})
+	for _, err := range all {
+		if nil != err {
+			return semvers, all
+		}
+	}
+	return semvers, nil
+}
+
+// LoadChangeLogParallel reads the changelog file at the given path, like
+// LoadChangeLog, and then validates the version and date of every entry
+// concurrently, which is considerably faster for changelogs with thousands of
+// entries.
+// Returns an error identifying the first invalid entry, if any.
+func LoadChangeLogParallel(path string) ([]Change, error) {
+	log, err := LoadChangeLog(path)
+	if nil != err {
+		return nil, err
+	}
+	cfg := globalConfig()
+	errs := make([]error, len(log))
+	parallel(len(log), func(i int) {
+		c := &log[i]
+		if err := cfg.Validate(c.Version); nil != err {
+			errs[i] = err
+		} else if "" != c.Date {
+			_, errs[i] = parseDateErr(c.Date, cfg.DateTimeLocation)
+		}
+	})
+	for i, err := range errs {
+		if nil != err {
+			return nil, fmt.Errorf("%s: entry %d: %v", path, i, err)
+		}
+	}
+	return log, nil
+}
@@ -0,0 +1,58 @@
+package version_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleParseAll() {
+	semvers, errs := version.ParseAll([]string{"1.2.3", "v2", "0.1.0-rc.1"})
+	for i, v := range semvers {
+		if nil != errs[i] {
+			fmt.Println("invalid")
+			continue
+		}
+		fmt.Println(v.Major, v.Minor, v.Patch)
+	}
+	// Output:
+	// 1 2 3
+	// invalid
+	// 0 1 0
+}
+
+func TestLoadChangeLogParallel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bulk")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	log := make([]version.Change, 5000)
+	for i := range log {
+		log[i] = version.Change{Version: fmt.Sprintf("%d.%d.0", i/100, i%100), Date: "2020-03-09"}
+	}
+	path := filepath.Join(dir, "changelog.json")
+	if err := version.NewFileStore(path).Save(log); nil != err {
+		t.Fatal(err)
+	}
+	got, err := version.LoadChangeLogParallel(path)
+	if nil != err || len(got) != len(log) {
+		t.Fatalf("LoadChangeLogParallel() = %d entries, %v", len(got), err)
+	}
+
+	log[4321].Date = "yesterday"
+	log[1234].Version = "1.2"
+	if err := version.NewFileStore(path).Save(log); nil != err {
+		t.Fatal(err)
+	}
+	if _, err := version.LoadChangeLogParallel(path); nil == err ||
+		!strings.Contains(err.Error(), "entry 1234:") {
+		t.Errorf("LoadChangeLogParallel() error = %v, want entry 1234", err)
+	}
+}
@@ -0,0 +1,72 @@
+package version
+
+// BumpMajor returns the version that follows the given version by incrementing
+// its major component and resetting its minor and patch components.
+// A prerelease of a major version (e.g., 2.0.0-rc.1) is instead bumped to its
+// release (2.0.0). Build metadata is always discarded.
+// It panics if the given version string is invalid.
+func BumpMajor(version string) string {
+	major, minor, patch, pre, _ := Parse(version)
+	if "" == pre || 0 != minor || 0 != patch {
+		major, minor, patch = major+1, 0, 0
+	}
+	return format(major, minor, patch, "", "")
+}
+
+// BumpMinor returns the version that follows the given version by incrementing
+// its minor component and resetting its patch component.
+// A prerelease of a minor version (e.g., 1.3.0-rc.1) is instead bumped to its
+// release (1.3.0). Build metadata is always discarded.
+// It panics if the given version string is invalid.
+func BumpMinor(version string) string {
+	major, minor, patch, pre, _ := Parse(version)
+	if "" == pre || 0 != patch {
+		minor, patch = minor+1, 0
+	}
+	return format(major, minor, patch, "", "")
+}
+
+// BumpPatch returns the version that follows the given version by incrementing
+// its patch component.
+// A prerelease (e.g., 1.2.3-rc.1) is instead bumped to its release (1.2.3).
+// Build metadata is always discarded.
+// It panics if the given version string is invalid.
+func BumpPatch(version string) string {
+	major, minor, patch, pre, _ := Parse(version)
+	if "" == pre {
+		patch++
+	}
+	return format(major, minor, patch, "", "")
+}
+
+// NextBreaking returns the lowest version that is a breaking upgrade from the
+// given version; i.e., the exclusive upper bound of the caret range "^version".
+// Before 1.0.0, the leftmost non-zero component is treated as the major version
+// (e.g., 0.2.3 is followed by 0.3.0, and 0.0.3 by 0.0.4). Prerelease and build
+// metadata are discarded.
+// It panics if the given version string is invalid.
+func NextBreaking(version string) string {
+	major, minor, patch, _, _ := Parse(version)
+	switch {
+	case major > 0:
+		return format(major+1, 0, 0, "", "")
+	case minor > 0:
+		return format(0, minor+1, 0, "", "")
+	}
+	return format(0, 0, patch+1, "", "")
+}
+
+// NextCompatible returns the exclusive upper bound of the upgrades from the
+// given version limited to patch-level changes; i.e., the tilde range
+// "~version" (similar to the "compatible release" operator "~=" of Python). For
+// example, upgrades compatible with 1.2.3 are below 1.3.0. The bound never
+// exceeds NextBreaking, so upgrades compatible with 0.0.3 are below 0.0.4.
+// Prerelease and build metadata are discarded.
+// It panics if the given version string is invalid.
+func NextCompatible(version string) string {
+	major, minor, patch, _, _ := Parse(version)
+	if 0 == major && 0 == minor {
+		return format(0, 0, patch+1, "", "")
+	}
+	return format(major, minor+1, 0, "", "")
+}
@@ -0,0 +1,41 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestBump(t *testing.T) {
+	for _, tc := range []struct {
+		in, major, minor, patch string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "1.2.4"},
+		{"0.0.0+meta", "1.0.0", "0.1.0", "0.0.1"},
+		{"1.2.3-rc.1", "2.0.0", "1.3.0", "1.2.3"},
+		{"1.3.0-rc.1", "2.0.0", "1.3.0", "1.3.0"},
+		{"2.0.0-rc.1", "2.0.0", "2.0.0", "2.0.0"},
+	} {
+		if got := version.BumpMajor(tc.in); got != tc.major {
+			t.Errorf("BumpMajor(%q) = %q, want %q", tc.in, got, tc.major)
+		}
+		if got := version.BumpMinor(tc.in); got != tc.minor {
+			t.Errorf("BumpMinor(%q) = %q, want %q", tc.in, got, tc.minor)
+		}
+		if got := version.BumpPatch(tc.in); got != tc.patch {
+			t.Errorf("BumpPatch(%q) = %q, want %q", tc.in, got, tc.patch)
+		}
+	}
+}
+
+func ExampleNextBreaking() {
+	for _, v := range []string{"1.2.3", "0.2.3", "0.0.3", "2.0.0-rc.1+build"} {
+		fmt.Println(v, version.NextBreaking(v), version.NextCompatible(v))
+	}
+	// Output:
+	// 1.2.3 2.0.0 1.3.0
+	// 0.2.3 0.3.0 0.3.0
+	// 0.0.3 0.0.4 0.0.4
+	// 2.0.0-rc.1+build 3.0.0 2.1.0
+}
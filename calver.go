@@ -0,0 +1,319 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalVerFormat selects calendar versioning (see https://calver.org) for the
+// entries in ChangeLog when non-empty. It is a sequence of the following tokens
+// separated by '.':
+//
+//	YYYY    full year            2006, 2016, 2106
+//	YY      short year           6, 16, 106
+//	0Y      zero-padded year     06, 16, 106
+//	MM      short month          1, 2 ... 11, 12
+//	0M      zero-padded month    01, 02 ... 11, 12
+//	WW      short ISO week       1, 2, 33, 52
+//	0W      zero-padded week     01, 02, 33, 52
+//	DD      short day            1, 2 ... 30, 31
+//	0D      zero-padded day      01, 02 ... 30, 31
+//	MAJOR   incrementing number  0, 1, 2 ...
+//	MINOR   incrementing number  0, 1, 2 ...
+//	MICRO   incrementing number  0, 1, 2 ...
+//
+// For example, "YYYY.0M.MICRO" matches "2020.03.1". A version may also carry a
+// modifier suffix separated by '-' (e.g., "2020.03.1-beta").
+//
+// If empty, ChangeLog entries use semantic versions validated by VersionPattern.
+var CalVerFormat string
+
+// CalVer represents the components of a calendar version.
+// Components that do not appear in Format are zero.
+type CalVer struct {
+	Format   string
+	Year     int
+	Month    int
+	Week     int
+	Day      int
+	Major    int
+	Minor    int
+	Micro    int
+	Modifier string
+}
+
+// calverTokens returns the tokens of a CalVer format, or an error if the format
+// contains unrecognized tokens.
+func calverTokens(format string) ([]string, error) {
+	if "" == format {
+		return nil, errors.New("empty CalVer format")
+	}
+	tok := strings.Split(format, ".")
+	seen := map[string]bool{}
+	for _, t := range tok {
+		switch t {
+		case "YYYY", "YY", "0Y", "MM", "0M", "WW", "0W", "DD", "0D",
+			"MAJOR", "MINOR", "MICRO":
+		default:
+			return nil, fmt.Errorf("invalid CalVer format %q: unknown token %q", format, t)
+		}
+		if seen[t] {
+			return nil, fmt.Errorf("invalid CalVer format %q: repeated token %q", format, t)
+		}
+		seen[t] = true
+	}
+	return tok, nil
+}
+
+// calverNumber parses a numeric component. Zero-padded components have at
+// least two digits; all others must not have leading zeros.
+func calverNumber(s string, padded bool) (int, error) {
+	if "" == s {
+		return 0, errors.New("empty component")
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("non-numeric component %q", s)
+		}
+	}
+	switch {
+	case padded && len(s) < 2:
+		return 0, fmt.Errorf("component %q not zero-padded", s)
+	case padded && len(s) > 2 && '0' == s[0], !padded && len(s) > 1 && '0' == s[0]:
+		return 0, fmt.Errorf("leading zero in component %q", s)
+	}
+	return strconv.Atoi(s)
+}
+
+// ParseCalVer parses a calendar version string according to the given format.
+// See CalVerFormat for a description of the format.
+func ParseCalVer(format, version string) (CalVer, error) {
+	tok, err := calverTokens(format)
+	if nil != err {
+		return CalVer{}, err
+	}
+	v := CalVer{Format: format}
+	s := version
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s, v.Modifier = s[:i], s[i+1:]
+		if "" == v.Modifier || strings.IndexFunc(v.Modifier, func(c rune) bool {
+			return !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' ||
+				c >= 'A' && c <= 'Z' || '.' == c || '-' == c)
+		}) >= 0 {
+			return CalVer{}, fmt.Errorf("invalid CalVer %q: invalid modifier", version)
+		}
+	}
+	seg := strings.Split(s, ".")
+	if len(seg) != len(tok) {
+		return CalVer{}, fmt.Errorf("invalid CalVer %q: expected format %q", version, format)
+	}
+	for i, t := range tok {
+		n, err := calverNumber(seg[i], '0' == t[0])
+		if nil != err {
+			return CalVer{}, fmt.Errorf("invalid CalVer %q: %s: %v", version, t, err)
+		}
+		bad := false
+		switch t {
+		case "YYYY":
+			v.Year = n
+		case "YY", "0Y":
+			v.Year = 2000 + n
+		case "MM", "0M":
+			v.Month, bad = n, n < 1 || n > 12
+		case "WW", "0W":
+			v.Week, bad = n, n < 1 || n > 53
+		case "DD", "0D":
+			v.Day, bad = n, n < 1 || n > 31
+		case "MAJOR":
+			v.Major = n
+		case "MINOR":
+			v.Minor = n
+		case "MICRO":
+			v.Micro = n
+		}
+		if bad {
+			return CalVer{}, fmt.Errorf("invalid CalVer %q: %s out of range", version, t)
+		}
+	}
+	if v.Year > 0 && v.Month > 0 && v.Day > 0 &&
+		v.Date().Day() != v.Day {
+		return CalVer{}, fmt.Errorf("invalid CalVer %q: invalid date", version)
+	}
+	return v, nil
+}
+
+// String returns the calendar version string of v in its Format.
+func (v CalVer) String() string {
+	b := strings.Builder{}
+	for i, t := range strings.Split(v.Format, ".") {
+		if i > 0 {
+			b.WriteRune('.')
+		}
+		switch t {
+		case "YYYY":
+			fmt.Fprintf(&b, "%d", v.Year)
+		case "YY":
+			fmt.Fprintf(&b, "%d", v.Year-2000)
+		case "0Y":
+			fmt.Fprintf(&b, "%02d", v.Year-2000)
+		case "MM":
+			fmt.Fprintf(&b, "%d", v.Month)
+		case "0M":
+			fmt.Fprintf(&b, "%02d", v.Month)
+		case "WW":
+			fmt.Fprintf(&b, "%d", v.Week)
+		case "0W":
+			fmt.Fprintf(&b, "%02d", v.Week)
+		case "DD":
+			fmt.Fprintf(&b, "%d", v.Day)
+		case "0D":
+			fmt.Fprintf(&b, "%02d", v.Day)
+		case "MAJOR":
+			fmt.Fprintf(&b, "%d", v.Major)
+		case "MINOR":
+			fmt.Fprintf(&b, "%d", v.Minor)
+		case "MICRO":
+			fmt.Fprintf(&b, "%d", v.Micro)
+		}
+	}
+	if "" != v.Modifier {
+		b.WriteRune('-')
+		b.WriteString(v.Modifier)
+	}
+	return b.String()
+}
+
+// Date returns the first day (in UTC) of the calendar period identified by v.
+// Components absent from the format default to the start of the period, e.g.,
+// "2020.03" with format "YYYY.0M" returns March 1, 2020.
+func (v CalVer) Date() time.Time {
+	if v.Week > 0 {
+		// Monday of ISO week 1 is the Monday on or before January 4.
+		jan4 := time.Date(v.Year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		mon := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		return mon.AddDate(0, 0, 7*(v.Week-1))
+	}
+	month, day := time.Month(v.Month), v.Day
+	if 0 == month {
+		month = time.January
+	}
+	if 0 == day {
+		day = 1
+	}
+	return time.Date(v.Year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Compare returns -1, 0, or +1 if v is older than, the same as, or newer than
+// calendar version o, respectively. Versions are ordered by date, then by
+// their MAJOR, MINOR, and MICRO components. A version with a modifier precedes
+// the same version without a modifier; modifiers are otherwise compared
+// lexically.
+func (v CalVer) Compare(o CalVer) int {
+	if d, e := v.Date(), o.Date(); !d.Equal(e) {
+		if d.Before(e) {
+			return -1
+		}
+		return 1
+	}
+	for _, p := range [][2]int{
+		{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Micro, o.Micro},
+	} {
+		if p[0] != p[1] {
+			if p[0] < p[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case v.Modifier == o.Modifier:
+		return 0
+	case "" == v.Modifier:
+		return 1
+	case "" == o.Modifier:
+		return -1
+	}
+	return strings.Compare(v.Modifier, o.Modifier)
+}
+
+// NextCalVer returns the calendar version, in CalVerFormat, of a release made
+// at the given time.
+// If the current package version (see String) falls in the same calendar period,
+// the last of the MAJOR, MINOR, or MICRO components in the format is
+// incremented; otherwise, it is reset to zero.
+// Returns an error if CalVerFormat is invalid, the current version does not
+// match it, or a new version cannot be distinguished from the current version.
+func NextCalVer(now time.Time) (string, error) {
+	cur, err := versionString()
+	if nil != err {
+		return "", err
+	}
+	return nextCalVer(CalVerFormat, cur, now)
+}
+
+// nextCalVer returns the calendar version, in the given format, of a release
+// made at the given time following version cur (if non-empty), as described by
+// NextCalVer.
+func nextCalVer(format, cur string, now time.Time) (string, error) {
+	tok, err := calverTokens(format)
+	if nil != err {
+		return "", err
+	}
+	next := CalVer{Format: format}
+	counter := ""
+	hasWeek := false
+	for _, t := range tok {
+		switch t {
+		case "MAJOR", "MINOR", "MICRO":
+			counter = t
+		case "WW", "0W":
+			hasWeek = true
+		}
+	}
+	for _, t := range tok {
+		switch t {
+		case "YYYY", "YY", "0Y":
+			next.Year = now.Year()
+			if hasWeek {
+				next.Year, _ = now.ISOWeek()
+			}
+		case "MM", "0M":
+			next.Month = int(now.Month())
+		case "WW", "0W":
+			_, next.Week = now.ISOWeek()
+		case "DD", "0D":
+			next.Day = now.Day()
+		}
+	}
+	if "" != cur {
+		prev, err := ParseCalVer(format, cur)
+		if nil != err {
+			return "", err
+		}
+		next.Major, next.Minor, next.Micro = prev.Major, prev.Minor, prev.Micro
+		same := prev.Date().Equal(next.Date())
+		switch counter {
+		case "MAJOR":
+			next.Major = incrementOrReset(same, prev.Major)
+		case "MINOR":
+			next.Minor = incrementOrReset(same, prev.Minor)
+		case "MICRO":
+			next.Micro = incrementOrReset(same, prev.Micro)
+		default:
+			if same {
+				return "", fmt.Errorf("version %s already released in this period", cur)
+			}
+		}
+	}
+	return next.String(), nil
+}
+
+func incrementOrReset(increment bool, n int) int {
+	if increment {
+		return n + 1
+	}
+	return 0
+}
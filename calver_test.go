@@ -0,0 +1,86 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestParseCalVer(t *testing.T) {
+	for _, tc := range []struct {
+		format, version string
+		valid           bool
+	}{
+		{"YYYY.0M.MICRO", "2020.03.1", true},
+		{"YYYY.0M.MICRO", "2020.3.1", false},
+		{"YYYY.MM.MICRO", "2020.03.1", false},
+		{"YY.MM.DD", "20.2.29", true},
+		{"YY.MM.DD", "21.2.29", false},
+		{"0Y.0W", "09.53", true},
+		{"0Y.0W", "09.54", false},
+		{"YYYY.MINOR.MICRO", "2020.0.1-beta.2", true},
+		{"YYYY.MINOR.MICRO", "2020.0.1-", false},
+		{"YYYY.MINOR.MICRO", "2020.01.1", false},
+		{"YYYY.MINOR", "2020.0.1", false},
+		{"YYYY.BOGUS", "2020.1", false},
+	} {
+		v, err := version.ParseCalVer(tc.format, tc.version)
+		if tc.valid != (nil == err) {
+			t.Errorf("ParseCalVer(%q, %q) error = %v, want valid = %t",
+				tc.format, tc.version, err, tc.valid)
+		} else if tc.valid && v.String() != tc.version {
+			t.Errorf("ParseCalVer(%q, %q).String() = %q",
+				tc.format, tc.version, v.String())
+		}
+	}
+}
+
+func TestCalVerCompare(t *testing.T) {
+	ordered := []string{"19.12.5", "20.2.0-rc", "20.2.0", "20.2.1", "20.10.0"}
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := version.ParseCalVer("YY.MM.MICRO", ordered[i])
+			b, _ := version.ParseCalVer("YY.MM.MICRO", ordered[j])
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.Compare(b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestNextCalVer(t *testing.T) {
+	format, log := version.CalVerFormat, version.ChangeLog
+	defer func() { version.CalVerFormat, version.ChangeLog = format, log }()
+
+	version.CalVerFormat = "YYYY.0M.MICRO"
+	version.ChangeLog = []version.Change{{Version: "2020.03.0"}}
+	now := time.Date(2020, time.March, 9, 17, 45, 23, 0, time.UTC)
+	for _, tc := range []struct {
+		now  time.Time
+		want string
+	}{
+		{now, "2020.03.1"},
+		{now.AddDate(0, 1, 0), "2020.04.0"},
+	} {
+		got, err := version.NextCalVer(tc.now)
+		if nil != err || got != tc.want {
+			t.Errorf("NextCalVer(%v) = %q, %v, want %q", tc.now, got, err, tc.want)
+		}
+	}
+	if got := version.String(); "2020.03.0" != got {
+		t.Errorf("String() = %q, want %q", got, "2020.03.0")
+	}
+
+	version.CalVerFormat = "YY.0M.0D"
+	version.ChangeLog = []version.Change{{Version: "20.03.09"}}
+	if _, err := version.NextCalVer(now); nil == err {
+		t.Error("NextCalVer() expected error for same-day release without counter")
+	}
+}
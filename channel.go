@@ -0,0 +1,74 @@
+package version
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Names of the release channels returned by Channel.
+const (
+	ChannelStable = "stable"
+	ChannelRC     = "rc"
+	ChannelBeta   = "beta"
+	ChannelAlpha  = "alpha"
+	ChannelDev    = "dev"
+)
+
+// ChannelMapping maps the leading prerelease identifier of a version, in lower
+// case and without trailing digits, to the name of its release channel (see
+// Channel). Prereleases whose identifier is not mapped belong to ChannelDev.
+// Applications may add or replace mappings to suit their own conventions.
+var ChannelMapping = map[string]string{
+	"rc":       ChannelRC,
+	"pre":      ChannelRC,
+	"preview":  ChannelRC,
+	"beta":     ChannelBeta,
+	"b":        ChannelBeta,
+	"alpha":    ChannelAlpha,
+	"a":        ChannelAlpha,
+	"dev":      ChannelDev,
+	"snapshot": ChannelDev,
+	"nightly":  ChannelDev,
+}
+
+// Channel returns the release channel of the given semantic version string,
+// for update systems offering multiple tracks. Versions without a prerelease
+// component are ChannelStable; otherwise, the leading prerelease identifier is
+// looked up in ChannelMapping, ignoring case and trailing digits, so that
+// "1.2.0-rc.1" and "1.2.0-RC2" are both ChannelRC.
+// Returns an empty string if the version string is invalid.
+func Channel(v string) string {
+	if !IsValid(v) {
+		return ""
+	}
+	if !isPrerelease(v) {
+		return ChannelStable
+	}
+	_, _, _, pre, _ := Parse(v)
+	id := strings.ToLower(strings.SplitN(pre, ".", 2)[0])
+	id = strings.TrimRightFunc(id, unicode.IsDigit)
+	if ch, ok := ChannelMapping[id]; ok {
+		return ch
+	}
+	return ChannelDev
+}
+
+// LatestInChannel returns the entry in ChangeLog in the given release channel
+// (see Channel) with the highest version precedence, skipping entries marked
+// Yanked. Returns false if there is no such entry.
+func LatestInChannel(channel string) (Change, bool) {
+	return ChangeLog.LatestInChannel(channel)
+}
+
+// LatestInChannel returns the entry in History h in the given release channel
+// (see Channel) with the highest version precedence, skipping entries marked
+// Yanked. Returns false if there is no such entry.
+func (h History) LatestInChannel(channel string) (Change, bool) {
+	var in History
+	for _, c := range h {
+		if Channel(c.Version) == channel {
+			in = append(in, c)
+		}
+	}
+	return in.Latest()
+}
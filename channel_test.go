@@ -0,0 +1,46 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestChannel(t *testing.T) {
+	for v, want := range map[string]string{
+		"1.2.3":            version.ChannelStable,
+		"1.2.3+build.7":    version.ChannelStable,
+		"1.2.3-rc.1":       version.ChannelRC,
+		"1.2.3-RC2":        version.ChannelRC,
+		"1.2.3-beta":       version.ChannelBeta,
+		"1.2.3-alpha.3":    version.ChannelAlpha,
+		"1.2.3-nightly.20": version.ChannelDev,
+		"1.2.3-0.3.7":      version.ChannelDev,
+		"1.02.3":           "",
+	} {
+		if got := version.Channel(v); got != want {
+			t.Errorf("Channel(%q) = %q, want %q", v, got, want)
+		}
+	}
+}
+
+func ExampleHistory_LatestInChannel() {
+	h := version.History{
+		{Version: "1.0.0"},
+		{Version: "1.1.0-beta.1"},
+		{Version: "1.1.0-beta.2", Yanked: true},
+		{Version: "1.1.0-rc.1"},
+		{Version: "1.2.0-alpha.1"},
+	}
+	for _, ch := range []string{"stable", "rc", "beta", "alpha", "dev"} {
+		c, ok := h.LatestInChannel(ch)
+		fmt.Println(ch, c.Version, ok)
+	}
+	// Output:
+	// stable 1.0.0 true
+	// rc 1.1.0-rc.1 true
+	// beta 1.1.0-beta.1 true
+	// alpha 1.2.0-alpha.1 true
+	// dev  false
+}
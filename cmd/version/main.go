@@ -0,0 +1,472 @@
+// Command version manages a project changelog using the same semantics as the
+// github.com/ardnew/version package.
+//
+// Usage:
+//
+//	version [-f FILE] <command> [arguments]
+//
+// The commands are:
+//
+//	show       print the package name and current version
+//	render     print every entry in the changelog
+//	search     print entries whose title or description matches a query
+//	validate   verify the version and date of every entry
+//	lint       check every entry against house rules (see version.LintRules)
+//	bump       append a new entry with the next major, minor, or patch version,
+//	           or that inferred from its description categories
+//	generate   write Go source that assigns the changelog to version.ChangeLog
+//	winres     write the Windows version resource (versioninfo.json)
+//
+// The changelog format is selected by the file name extension of FILE:
+//
+//	.json      JSON array of entries (see version.ReadChangeLog)
+//	.md        keepachangelog Markdown (see version.ReadMarkdown)
+//
+// The export command may also write CSV (.csv, see version.WriteCSV), which
+// cannot be read back.
+//
+// The generate command is intended for use with go:generate, so that a
+// human-edited changelog and the data compiled into an executable never drift:
+//
+//	//go:generate go run github.com/ardnew/version/cmd/version -f CHANGELOG.md generate
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ardnew/version"
+)
+
+const defaultFile = "CHANGELOG.json"
+
+func main() {
+	log := flag.String("f", defaultFile, "changelog `file`")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "show":
+		err = show(*log, args)
+	case "render":
+		err = render(*log, args)
+	case "diff":
+		err = diff(*log, args)
+	case "notes":
+		err = notes(*log, args)
+	case "search":
+		err = search(*log, args)
+	case "validate":
+		err = validate(*log, args)
+	case "lint":
+		err = lint(*log, args)
+	case "bump":
+		err = bump(*log, args)
+	case "generate":
+		err = generate(*log, args)
+	case "export":
+		err = export(*log, args)
+	case "winres":
+		err = winres(*log, args)
+	default:
+		fmt.Fprintf(os.Stderr, "version: unknown command %q\n", cmd)
+		usage()
+		os.Exit(2)
+	}
+	if nil != err {
+		fmt.Fprintf(os.Stderr, "version: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: version [-f FILE] <command> [arguments]\n\n")
+	fmt.Fprintf(os.Stderr, "commands:\n")
+	fmt.Fprintf(os.Stderr, "  show       print the package name and current version\n")
+	fmt.Fprintf(os.Stderr, "  render     print every entry in the changelog\n")
+	fmt.Fprintf(os.Stderr, "  diff       compare the changelog with another changelog file\n")
+	fmt.Fprintf(os.Stderr, "  notes      print the release notes of a single version\n")
+	fmt.Fprintf(os.Stderr, "  search     print entries matching a query (/regexp/ or text)\n")
+	fmt.Fprintf(os.Stderr, "  validate   verify the version and date of every entry\n")
+	fmt.Fprintf(os.Stderr, "  lint       check every entry against house rules\n")
+	fmt.Fprintf(os.Stderr, "  bump       append a new entry with the next version\n")
+	fmt.Fprintf(os.Stderr, "  generate   write Go source defining version.ChangeLog\n")
+	fmt.Fprintf(os.Stderr, "  export     write the changelog to a file (.json, .md, or .csv)\n")
+	fmt.Fprintf(os.Stderr, "  winres     write the Windows version resource (versioninfo.json)\n\n")
+	fmt.Fprintf(os.Stderr, "flags:\n")
+	flag.PrintDefaults()
+}
+
+// formats lists the file name extensions of supported changelog formats, and
+// exportFormats those that may only be written by export.
+var (
+	formats       = map[string]bool{".json": true, ".md": true}
+	exportFormats = map[string]bool{".json": true, ".md": true, ".csv": true}
+)
+
+// storeFor returns the version.Store for the changelog file at path, whose
+// extension must be one of the given formats.
+func storeFor(path string, supported map[string]bool) (version.Store, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if !supported[ext] {
+		return nil, fmt.Errorf("%s: unsupported changelog format %q", path, ext)
+	}
+	return version.NewFileStore(path), nil
+}
+
+func load(path string) ([]version.Change, error) {
+	s, err := storeFor(path, formats)
+	if nil != err {
+		return nil, err
+	}
+	return s.Load()
+}
+
+func store(path string, log []version.Change) error {
+	s, err := storeFor(path, formats)
+	if nil != err {
+		return err
+	}
+	return s.Save(log)
+}
+
+// check returns an error describing the first invalid entry in log, whose
+// versions are validated by version.VersionScheme if defined.
+func check(log []version.Change) error {
+	valid := version.IsValid
+	if s := version.VersionScheme; nil != s {
+		valid = func(v string) bool { return nil == s.Validate(v) }
+	}
+	seen := map[string]bool{}
+	for i, c := range log {
+		if !valid(c.Version) {
+			return fmt.Errorf("entry %d: invalid version %q", i, c.Version)
+		}
+		key := c.Module + "@" + c.Version // versions are unique per module
+		if seen[key] {
+			return fmt.Errorf("entry %d: duplicate version %q", i, c.Version)
+		}
+		seen[key] = true
+		if "" != c.Date && nil == version.ParseDate(c.Date) {
+			return fmt.Errorf("entry %d: unrecognized date %q", i, c.Date)
+		}
+	}
+	return nil
+}
+
+func show(path string, args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	format := fs.String("format", "", "output `format`: plain, json, or a Go template")
+	fs.Parse(args)
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return err
+	}
+	version.ChangeLog = log
+	return version.FprintPackageVersionFormat(os.Stdout, *format)
+}
+
+func render(path string, args []string) error {
+	fs := flag.NewFlagSet("render", flag.ExitOnError)
+	recent := fs.Int("n", 0, "print only the last `N` entries")
+	format := fs.String("format", "", "print using the named `formatter` ("+
+		strings.Join(version.Formatters(), ", ")+")")
+	summary := fs.Bool("summary", false, "print a table of version, date, and title")
+	group := fs.Bool("group", false, "group entries by major/minor series (text or markdown)")
+	fs.Parse(args)
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return err
+	}
+	version.ChangeLog = log
+	if *summary {
+		version.ChangeLog = version.Recent(*recent)
+		return version.FprintChangeLogSummary(os.Stdout)
+	}
+	if *group {
+		version.ChangeLog = version.Recent(*recent)
+		switch *format {
+		case "", version.FormatText:
+			return version.FprintGroupedChangeLog(os.Stdout)
+		case version.FormatMarkdown:
+			return version.WriteGroupedMarkdown(os.Stdout, version.ChangeLog)
+		}
+		return fmt.Errorf("cannot group entries with formatter %q", *format)
+	}
+	if "" == *format {
+		return version.FprintRecentChanges(os.Stdout, *recent)
+	}
+	f, ok := version.LookupFormatter(*format)
+	if !ok {
+		return fmt.Errorf("unknown formatter %q", *format)
+	}
+	return f.FormatChangeLog(os.Stdout, version.Recent(*recent))
+}
+
+func diff(path string, args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.Parse(args)
+	if 1 != fs.NArg() {
+		return errors.New("diff: expected one changelog file argument")
+	}
+	d, err := version.CompareChangeLogFiles(path, fs.Arg(0))
+	if nil != err {
+		return err
+	}
+	if !d.Empty() {
+		fmt.Print(d)
+		return fmt.Errorf("%s and %s differ", path, fs.Arg(0))
+	}
+	return nil
+}
+
+func notes(path string, args []string) error {
+	fs := flag.NewFlagSet("notes", flag.ExitOnError)
+	format := fs.String("format", version.ReleaseNotesFormat,
+		"print using the named `formatter` ("+
+			strings.Join(version.Formatters(), ", ")+")")
+	fs.Parse(args)
+	if 1 != fs.NArg() {
+		return errors.New("notes: expected exactly one version")
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	version.ChangeLog = log
+	return version.FprintReleaseNotes(os.Stdout, fs.Arg(0), *format)
+}
+
+func search(path string, args []string) error {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.Parse(args)
+	if 1 != fs.NArg() {
+		return errors.New("search: expected one query argument")
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	version.ChangeLog = log
+	found, err := version.SearchChanges(fs.Arg(0))
+	if nil != err {
+		return err
+	}
+	version.ChangeLog = found
+	return version.PrintChangeLog()
+}
+
+func validate(path string, args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.Parse(args)
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	fmt.Printf("%s: %d entries ok\n", path, len(log))
+	return nil
+}
+
+func lint(path string, args []string) error {
+	rules := version.DefaultLintRules
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	fs.IntVar(&rules.MaxTitleLength, "title", rules.MaxTitleLength, "maximum title `length` (0 for no limit)")
+	fs.BoolVar(&rules.Imperative, "imperative", rules.Imperative, "require imperative descriptions")
+	categories := fs.String("categories", "", "comma-separated `list` of required description categories")
+	fs.BoolVar(&rules.RequireDates, "dates", rules.RequireDates, "require dates for released versions")
+	fs.BoolVar(&rules.RequireDescription, "description", rules.RequireDescription, "require a description")
+	fs.Parse(args)
+	if "" != *categories {
+		rules.Categories = strings.Split(*categories, ",")
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	findings := version.History(log).Lint(rules)
+	for _, f := range findings {
+		fmt.Printf("%s: %s\n", path, f)
+	}
+	if n := len(findings); n > 0 {
+		return fmt.Errorf("%s: %d findings", path, n)
+	}
+	return nil
+}
+
+func bump(path string, args []string) error {
+	fs := flag.NewFlagSet("bump", flag.ExitOnError)
+	title := fs.String("title", "", "`title` of the new entry")
+	date := fs.String("date", version.Now().Format("2006-01-02"), "`date` of the new entry")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: version bump [flags] major|minor|patch|auto [description ...]\n\n")
+		fmt.Fprintf(os.Stderr, "auto infers the part from the description categories (see version.SuggestBump).\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	log, err := load(path)
+	if nil != err && !os.IsNotExist(err) {
+		return err
+	}
+	if err := check(log); nil != err {
+		return err
+	}
+
+	prev := version.Change{Version: "0.0.0"}
+	if len(log) > 0 {
+		prev = log[len(log)-1]
+	}
+
+	next := version.Change{
+		Package:     prev.Package,
+		Title:       *title,
+		Date:        *date,
+		Description: fs.Args()[1:],
+	}
+	part := fs.Arg(0)
+	if "auto" == part {
+		if part = version.SuggestBump([]version.Change{next}); "" == part {
+			return errors.New("bump: cannot infer the part to bump from the description; " +
+				"prefix lines with a category (e.g., \"Added: ...\")")
+		}
+	}
+	if next.Version, err = version.Bump(prev.Version, part); nil != err {
+		return err
+	}
+	if "" != next.Date && nil == version.ParseDate(next.Date) {
+		return fmt.Errorf("bump: unrecognized date %q", next.Date)
+	}
+
+	if err := store(path, append(log, next)); nil != err {
+		return err
+	}
+	fmt.Println(next.Version)
+	return nil
+}
+
+func generate(path string, args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	out := fs.String("o", "zz_changelog.go", "output `file`")
+	pkg := fs.String("p", os.Getenv("GOPACKAGE"), "output `package` name (default $GOPACKAGE)")
+	fs.Parse(args)
+	if "" == *pkg {
+		*pkg = "main"
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"version generate\" from %s; DO NOT EDIT.\n\n",
+		filepath.Base(path))
+	fmt.Fprintf(&b, "package %s\n\n", *pkg)
+	fmt.Fprintf(&b, "import \"github.com/ardnew/version\"\n\n")
+	fmt.Fprintf(&b, "func init() {\n\tversion.ChangeLog = []version.Change{\n")
+	for _, c := range log {
+		b.WriteString("{\n")
+		// write only the fields that are set
+		v := reflect.ValueOf(c)
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); !f.IsZero() {
+				fmt.Fprintf(&b, "%s: %#v,\n", v.Type().Field(i).Name, f.Interface())
+			}
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n}\n")
+
+	src, err := format.Source(b.Bytes())
+	if nil != err {
+		return err
+	}
+	return ioutil.WriteFile(*out, src, 0644)
+}
+
+func export(path string, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.Parse(args)
+	if 1 != fs.NArg() {
+		return errors.New("export: expected one output file argument")
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return err
+	}
+	s, err := storeFor(fs.Arg(0), exportFormats)
+	if nil != err {
+		return err
+	}
+	return s.Save(log)
+}
+
+func winres(path string, args []string) error {
+	fs := flag.NewFlagSet("winres", flag.ExitOnError)
+	out := fs.String("o", "versioninfo.json", "output `file` (\"-\" for stdout)")
+	company := fs.String("company", "", "company `name`")
+	copyright := fs.String("copyright", "", "legal copyright `notice`")
+	description := fs.String("description", "", "file `description`")
+	icon := fs.String("icon", "", "icon `file` path")
+	legacy := fs.Bool("legacy", false, "versions are four-component MAJOR.MINOR.PATCH.BUILD")
+	fs.Parse(args)
+	if *legacy {
+		version.VersionScheme = version.LegacyScheme
+	}
+	log, err := load(path)
+	if nil != err {
+		return err
+	}
+	if err := check(log); nil != err {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	version.ChangeLog = log
+	info, err := version.CurrentWindowsVersionInfo()
+	if nil != err {
+		return err
+	}
+	info.StringFileInfo.CompanyName = *company
+	info.StringFileInfo.LegalCopyright = *copyright
+	info.StringFileInfo.FileDescription = *description
+	info.IconPath = *icon
+	if "-" == *out {
+		return version.WriteWindowsVersionInfo(os.Stdout, info)
+	}
+	var b bytes.Buffer
+	if err := version.WriteWindowsVersionInfo(&b, info); nil != err {
+		return err
+	}
+	return ioutil.WriteFile(*out, b.Bytes(), 0644)
+}
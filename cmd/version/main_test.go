@@ -0,0 +1,153 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+// fixture is the changelog written to each test file.
+var fixture = []version.Change{
+	{Package: "tool", Version: "1.0.0", Date: "2020-01-10", Description: []string{"Added: widgets"}},
+	{Package: "tool", Version: "1.1.0", Date: "2020-03-09", Title: "Red Label", Description: []string{"Fixed: crash"}},
+}
+
+// runCommand runs the subcommand cmd with the given changelog file and
+// arguments, and returns what it wrote to standard output.
+func runCommand(t *testing.T, cmd func(string, []string) error, path string, args ...string) (string, error) {
+	t.Helper()
+	defer func(log version.History, v version.Semver, stdout *os.File) {
+		version.ChangeLog, version.Version, os.Stdout = log, v, stdout
+	}(version.ChangeLog, version.Version, os.Stdout)
+	out, err := ioutil.TempFile("", "stdout")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.Remove(out.Name())
+	defer out.Close()
+	os.Stdout = out
+	cmdErr := cmd(path, args)
+	b, err := ioutil.ReadFile(out.Name())
+	if nil != err {
+		t.Fatal(err)
+	}
+	return string(b), cmdErr
+}
+
+// writeFixture writes log to a new changelog file named name in dir, in the
+// format selected by its extension, and returns its path.
+func writeFixture(t *testing.T, dir, name string, log []version.Change) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := version.NewFileStore(path).Save(log); nil != err {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "version")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := ioutil.WriteFile(invalid, []byte(`[{"version":"1.0"}]`), 0644); nil != err {
+		t.Fatal(err)
+	}
+
+	for _, ext := range []string{".json", ".md"} {
+		for _, tc := range []struct {
+			name    string
+			cmd     func(string, []string) error
+			args    []string
+			want    string // substring of the output
+			wantErr bool
+		}{
+			{"validate", validate, nil, "2 entries ok", false},
+			{"show", show, nil, "version 1.1.0", false}, // Markdown has no package name
+			{"show-json", show, []string{"-format", "json"}, `"version": "1.1.0"`, false},
+			{"render", render, nil, "Red Label", false},
+			{"render-recent", render, []string{"-n", "1", "-format", "markdown"}, "1.1.0", false},
+			{"render-unknown", render, []string{"-format", "nope"}, "", true},
+		} {
+			path := writeFixture(t, dir, tc.name+ext, fixture)
+			out, err := runCommand(t, tc.cmd, path, tc.args...)
+			if tc.wantErr != (nil != err) || !strings.Contains(out, tc.want) {
+				t.Errorf("%s %s: output %q, error %v; want %q, error %t",
+					tc.name, filepath.Base(path), out, err, tc.want, tc.wantErr)
+			}
+		}
+	}
+	for _, cmd := range []func(string, []string) error{validate, show, render} {
+		if _, err := runCommand(t, cmd, invalid); nil == err {
+			t.Errorf("invalid changelog: expected error")
+		}
+	}
+	for _, name := range []string{"log.txt", "log.csv"} {
+		if _, err := runCommand(t, validate, filepath.Join(dir, name)); nil == err ||
+			!strings.Contains(err.Error(), "unsupported") {
+			t.Errorf("validate %s: error %v, want unsupported format", name, err)
+		}
+	}
+	csv := filepath.Join(dir, "export.csv")
+	if _, err := runCommand(t, export, writeFixture(t, dir, "export.json", fixture), csv); nil != err {
+		t.Errorf("export %s: %v", filepath.Base(csv), err)
+	} else if b, _ := ioutil.ReadFile(csv); !strings.Contains(string(b), "1.1.0,2020-03-09,Red Label") {
+		t.Errorf("export %s wrote %q", filepath.Base(csv), b)
+	}
+}
+
+func TestBump(t *testing.T) {
+	dir, err := ioutil.TempDir("", "version")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, ext := range []string{".json", ".md"} {
+		for _, tc := range []struct {
+			name    string
+			log     []version.Change // nil for a new file
+			args    []string
+			want    string // new version, or "" if an error is expected
+			entries int
+		}{
+			{"minor", fixture, []string{"-date", "2020-04-01", "minor", "Added: gadgets"}, "1.2.0", 3},
+			{"patch", fixture, []string{"-date", "2020-04-01", "patch", "Fixed: leak"}, "1.1.1", 3},
+			{"auto", fixture, []string{"-date", "2020-04-01", "auto", "Removed: widgets"}, "2.0.0", 3},
+			{"auto-none", fixture, []string{"-date", "2020-04-01", "auto", "tidy"}, "", 2},
+			{"bad-part", fixture, []string{"-date", "2020-04-01", "huge"}, "", 2},
+			{"bad-date", fixture, []string{"-date", "someday", "patch"}, "", 2},
+			{"new", nil, []string{"-date", "2020-04-01", "minor", "Added: everything"}, "0.1.0", 1},
+		} {
+			path := filepath.Join(dir, tc.name+ext)
+			if nil != tc.log {
+				path = writeFixture(t, dir, tc.name+ext, tc.log)
+			}
+			out, err := runCommand(t, bump, path, tc.args...)
+			if "" == tc.want {
+				if nil == err {
+					t.Errorf("bump %s: expected error", filepath.Base(path))
+				}
+			} else if nil != err || tc.want != strings.TrimSpace(out) {
+				t.Errorf("bump %s = %q, %v; want %s", filepath.Base(path), out, err, tc.want)
+			}
+			log, err := version.NewFileStore(path).Load()
+			if nil != err && !(os.IsNotExist(err) && 0 == tc.entries) {
+				t.Errorf("bump %s: reload: %v", filepath.Base(path), err)
+				continue
+			}
+			if tc.entries != len(log) {
+				t.Errorf("bump %s: %d entries, want %d", filepath.Base(path), len(log), tc.entries)
+			} else if "" != tc.want && tc.want != log[len(log)-1].Version {
+				t.Errorf("bump %s: last version %s, want %s",
+					filepath.Base(path), log[len(log)-1].Version, tc.want)
+			}
+		}
+	}
+}
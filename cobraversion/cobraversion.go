@@ -0,0 +1,76 @@
+//go:build cobra
+// +build cobra
+
+// Package cobraversion integrates the github.com/ardnew/version package with
+// command-line applications built on github.com/spf13/cobra.
+//
+// This package is only compiled with the "cobra" build tag, so that the version
+// package itself does not depend on cobra:
+//
+//	go build -tags cobra
+package cobraversion
+
+import (
+	"strings"
+
+	"github.com/ardnew/version"
+	"github.com/spf13/cobra"
+)
+
+// Setup configures the --version flag of given root command to print the
+// package version using version.FprintPackageVersion, and adds to root the
+// subcommand returned by Command.
+// Returns an error, leaving root unmodified, if the version is invalid.
+func Setup(root *cobra.Command) error {
+	v, err := version.Default.VersionString()
+	if nil != err {
+		return err
+	}
+	b := strings.Builder{}
+	if err := version.FprintPackageVersion(&b); nil != err {
+		return err
+	}
+	root.Version = v
+	root.SetVersionTemplate(b.String())
+	root.AddCommand(Command())
+	return nil
+}
+
+// Command returns a "version" subcommand that prints the package version.
+// The subcommand accepts the following flags:
+//
+//	--json        print version details as a JSON object
+//	--changelog   print every entry in version.ChangeLog
+//	--recent N    with --changelog, print only the last N entries
+//	--format T    print version details using Go template T (e.g. "{{.Version}}")
+//
+// With --changelog, --json prints the entries as a JSON array and --format is
+// ignored. Otherwise, --json takes precedence over --format.
+func Command() *cobra.Command {
+	var asJSON, changeLog bool
+	var recent int
+	var format string
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print version information",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := cmd.OutOrStdout()
+			switch {
+			case changeLog && asJSON:
+				return version.WriteChangeLog(w, version.Recent(recent))
+			case changeLog:
+				return version.FprintRecentChanges(w, recent)
+			case asJSON:
+				return version.FprintPackageVersionFormat(w, "json")
+			default:
+				return version.FprintPackageVersionFormat(w, format)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&asJSON, "json", false, "print in JSON format")
+	cmd.Flags().StringVar(&format, "format", "", "print using the given Go `template`")
+	cmd.Flags().BoolVar(&changeLog, "changelog", false, "print the changelog")
+	cmd.Flags().IntVar(&recent, "recent", 0, "print only the last `N` changelog entries")
+	return cmd
+}
@@ -0,0 +1,34 @@
+package version
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ReadChangeLog decodes from given io.Reader r a JSON array of Change entries,
+// ordered from oldest to newest.
+func ReadChangeLog(r io.Reader) ([]Change, error) {
+	var log []Change
+	if err := json.NewDecoder(r).Decode(&log); nil != err {
+		return nil, err
+	}
+	return log, nil
+}
+
+// WriteChangeLog encodes to given io.Writer w the given Change entries as an
+// indented JSON array.
+func WriteChangeLog(w io.Writer, log []Change) error {
+	if nil == log {
+		log = []Change{} // encode as empty array instead of null
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// LoadChangeLog reads the changelog file at the given path, decoded as Markdown
+// (see ReadMarkdown) if its name ends with ".md" or ".markdown", and as JSON (see
+// ReadChangeLog) otherwise. See NewFileStore.
+func LoadChangeLog(path string) ([]Change, error) {
+	return NewFileStore(path).Load()
+}
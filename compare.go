@@ -0,0 +1,147 @@
+package version
+
+import (
+	"strings"
+)
+
+// Compare returns an integer comparing the precedence of two semantic version
+// strings, as defined by the Semantic Versioning specification: -1 if a < b,
+// 0 if a and b have equal precedence, and +1 if a > b. Build metadata does not
+// affect precedence.
+// It panics if either of the given version strings is invalid.
+func Compare(a, b string) int {
+	var av, bv Semver
+	av.Major, av.Minor, av.Patch, av.Prerelease, _ = Parse(a)
+	bv.Major, bv.Minor, bv.Patch, bv.Prerelease, _ = Parse(b)
+	return av.Compare(bv)
+}
+
+// Compare returns an integer comparing the precedence of semantic versions v
+// and o: -1 if v < o, 0 if v and o have equal precedence, and +1 if v > o.
+// Build metadata does not affect precedence.
+func (v Semver) Compare(o Semver) int {
+	if c := compareUint(v.Major, o.Major); 0 != c {
+		return c
+	}
+	if c := compareUint(v.Minor, o.Minor); 0 != c {
+		return c
+	}
+	if c := compareUint(v.Patch, o.Patch); 0 != c {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+// CompareWithMetadata returns an integer comparing two semantic version strings
+// like Compare, except that versions of equal precedence are further ordered by
+// their build metadata. Metadata identifiers are compared in turn like
+// prerelease identifiers (numeric identifiers numerically, e.g. build counters),
+// and a version without metadata orders before one with metadata. This is not
+// part of the Semantic Versioning specification; use it only where metadata is
+// known to encode an ordering, such as CI build numbers.
+// It panics if either of the given version strings is invalid.
+func CompareWithMetadata(a, b string) int {
+	if c := Compare(a, b); 0 != c {
+		return c
+	}
+	_, _, _, _, am := Parse(a)
+	_, _, _, _, bm := Parse(b)
+	return compareMetadata(am, bm)
+}
+
+// compareMetadata compares two build metadata strings. A version without
+// metadata (empty string) orders before one with.
+func compareMetadata(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case "" == a:
+		return -1
+	case "" == b:
+		return 1
+	}
+	return CompareIdentifiers(SplitIdentifiers(a), SplitIdentifiers(b))
+}
+
+// compareVersions compares two version strings according to the versioning
+// scheme in use (see VersionScheme).
+// It panics if either of the given version strings is invalid.
+func compareVersions(a, b string) int {
+	return activeScheme().Compare(a, b)
+}
+
+func compareUint(a, b uint) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares two prerelease strings by precedence. A version
+// without prerelease (empty string) has higher precedence than one with.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case "" == a:
+		return 1
+	case "" == b:
+		return -1
+	}
+	return CompareIdentifiers(SplitIdentifiers(a), SplitIdentifiers(b))
+}
+
+// SplitIdentifiers returns the dot-separated identifiers of a prerelease or
+// build metadata string, such as ["rc", "3", "linux"] for "rc.3.linux".
+// Returns nil if s is empty.
+func SplitIdentifiers(s string) []string {
+	if "" == s {
+		return nil
+	}
+	return strings.Split(s, ".")
+}
+
+// IsNumericIdentifier returns true if and only if the given identifier consists
+// only of digits. Numeric prerelease identifiers are compared numerically, and
+// all others (alphanumeric identifiers) are compared lexically.
+func IsNumericIdentifier(id string) bool {
+	return isNumeric(id)
+}
+
+// CompareIdentifiers compares two sequences of prerelease identifiers by
+// precedence: identifiers are compared in turn by CompareIdentifier, and if all
+// are equal, the longer sequence has higher precedence.
+func CompareIdentifiers(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := CompareIdentifier(a[i], b[i]); 0 != c {
+			return c
+		}
+	}
+	return compareUint(uint(len(a)), uint(len(b)))
+}
+
+// CompareIdentifier compares two prerelease identifiers by precedence, returning
+// -1, 0, or +1. Numeric identifiers are compared numerically and have lower
+// precedence than alphanumeric identifiers, which are compared lexically in
+// ASCII sort order.
+func CompareIdentifier(a, b string) int {
+	an, bn := isNumeric(a), isNumeric(b)
+	switch {
+	case an && bn:
+		// ignore leading zeros (invalid in prerelease, but valid in metadata)
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		// then longer is greater
+		if c := compareUint(uint(len(a)), uint(len(b))); 0 != c {
+			return c
+		}
+		return strings.Compare(a, b)
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
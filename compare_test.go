@@ -0,0 +1,68 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCompare(t *testing.T) {
+	// ordered by precedence, from https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1",
+		"1.1.0", "2.0.0", "10.0.0",
+	}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := version.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+	if got := version.Compare("1.0.0+a", "1.0.0+b"); 0 != got {
+		t.Errorf("Compare() with build metadata = %d, want 0", got)
+	}
+}
+
+func TestCompareWithMetadata(t *testing.T) {
+	ordered := []string{
+		"1.0.0-rc.1+build.9", "1.0.0", "1.0.0+build.2", "1.0.0+build.010",
+		"1.0.0+build.10.x", "1.0.0+build.ci", "1.0.1+build.1",
+	}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := version.CompareWithMetadata(a, b); got != want {
+				t.Errorf("CompareWithMetadata(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func ExampleCompareIdentifiers() {
+	a := version.SplitIdentifiers("rc.3.linux")
+	b := version.SplitIdentifiers("rc.11")
+	for _, id := range a {
+		fmt.Println(id, version.IsNumericIdentifier(id))
+	}
+	fmt.Println(version.CompareIdentifiers(a, b))
+
+	// Output:
+	// rc false
+	// 3 true
+	// linux false
+	// -1
+}
@@ -0,0 +1,96 @@
+package version
+
+import "fmt"
+
+// Policy defines the rules used by Compatible to decide whether a client and
+// server may interoperate.
+type Policy struct {
+	// SameMajor requires client and server to have equal major versions. Since
+	// major version zero is for initial development, in which anything may
+	// change, equal minor versions are also required if the major version is 0.
+	SameMajor bool
+
+	// ClientNotNewer requires the client's minor version to be less than or
+	// equal to the server's, so the client does not depend on features unknown
+	// to the server. Only applies when major versions are equal.
+	ClientNotNewer bool
+
+	// MaxMinorSkew, if non-zero, is the maximum difference between the minor
+	// versions of client and server. Only applies when major versions are equal.
+	MaxMinorSkew uint
+
+	// AllowPrerelease permits prerelease versions to interoperate with other
+	// versions. Otherwise, a prerelease is compatible only with a version of
+	// equal precedence.
+	AllowPrerelease bool
+}
+
+// DefaultPolicy requires the same major version and a client minor version no
+// newer than the server's.
+var DefaultPolicy = Policy{SameMajor: true, ClientNotNewer: true}
+
+// Compatible returns true if and only if the given client and server semantic
+// version strings are compatible according to policy. If they are not, the
+// returned reason describes the rule that is violated, suitable for a warning
+// or for refusing a connection. Versions may be given in any form accepted by
+// ParseTolerant; invalid versions are never compatible.
+func Compatible(client, server string, policy Policy) (bool, string) {
+	c, err := ParseTolerant(client)
+	if nil != err {
+		return false, "client: " + err.Error()
+	}
+	s, err := ParseTolerant(server)
+	if nil != err {
+		return false, "server: " + err.Error()
+	}
+	if !policy.AllowPrerelease && ("" != c.Prerelease || "" != s.Prerelease) &&
+		0 != Compare(c.String(), s.String()) {
+		return false, fmt.Sprintf("prerelease version %s requires an identical peer, have %s",
+			prereleaseOf(c, s), peerOf(c, s))
+	}
+	if policy.SameMajor {
+		if c.Major != s.Major {
+			return false, fmt.Sprintf("client major version %d differs from server major version %d",
+				c.Major, s.Major)
+		}
+		if 0 == c.Major && c.Minor != s.Minor {
+			return false, fmt.Sprintf("client version %s and server version %s are unstable (major version 0) with different minor versions",
+				c, s)
+		}
+	}
+	if c.Major == s.Major {
+		if policy.ClientNotNewer && c.Minor > s.Minor {
+			return false, fmt.Sprintf("client minor version %d is newer than server minor version %d",
+				c.Minor, s.Minor)
+		}
+		if skew := diffUint(c.Minor, s.Minor); policy.MaxMinorSkew > 0 && skew > policy.MaxMinorSkew {
+			return false, fmt.Sprintf("client and server minor versions differ by %d, more than %d",
+				skew, policy.MaxMinorSkew)
+		}
+	}
+	return true, ""
+}
+
+// prereleaseOf returns whichever of a and b has a prerelease (a if both).
+func prereleaseOf(a, b Semver) Semver {
+	if "" != a.Prerelease {
+		return a
+	}
+	return b
+}
+
+// peerOf returns whichever of a and b is not returned by prereleaseOf.
+func peerOf(a, b Semver) Semver {
+	if "" != a.Prerelease {
+		return b
+	}
+	return a
+}
+
+// diffUint returns the absolute difference between a and b.
+func diffUint(a, b uint) uint {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
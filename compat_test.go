@@ -0,0 +1,43 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCompatible(t *testing.T) {
+	skew := version.Policy{SameMajor: true, MaxMinorSkew: 1}
+	for _, tc := range []struct {
+		client, server string
+		policy         version.Policy
+		want           bool
+	}{
+		{"1.2.0", "1.4.1", version.DefaultPolicy, true},
+		{"1.5.0", "1.4.1", version.DefaultPolicy, false},
+		{"2.0.0", "1.4.1", version.DefaultPolicy, false},
+		{"v0.3.1", "0.3.0", version.DefaultPolicy, true},
+		{"0.2.0", "0.3.0", version.DefaultPolicy, false},
+		{"1.4.0-rc.1", "1.4.0", version.DefaultPolicy, false},
+		{"1.4.0-rc.1", "1.4.0-rc.1+build", version.DefaultPolicy, true},
+		{"1.3.0-rc.1", "1.4.0", version.Policy{SameMajor: true, AllowPrerelease: true}, true},
+		{"1.5.0", "1.4.0", skew, true},
+		{"1.6.0", "1.4.0", skew, false},
+		{"3.0.0", "1.0.0", version.Policy{}, true},
+		{"bogus", "1.0.0", version.Policy{}, false},
+	} {
+		if ok, reason := version.Compatible(tc.client, tc.server, tc.policy); ok != tc.want {
+			t.Errorf("Compatible(%q, %q, %+v) = %t (%s), want %t",
+				tc.client, tc.server, tc.policy, ok, reason, tc.want)
+		}
+	}
+}
+
+func ExampleCompatible() {
+	ok, reason := version.Compatible("1.5.0", "1.4.1", version.DefaultPolicy)
+	fmt.Println(ok, reason)
+
+	// Output:
+	// false client minor version 5 is newer than server minor version 4
+}
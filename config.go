@@ -0,0 +1,286 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Config holds the settings used to validate, parse, and format versions and
+// changelogs. Unlike the corresponding package-level settings (VersionPattern,
+// CalVerFormat, DateTimeFormat, DateTimeLocation, MinSupported,
+// DefaultRenderOptions, ChangeTemplate, and Now), a Config is owned by its
+// user, so libraries sharing a process need not mutate global state. Construct
+// one with NewConfig.
+type Config struct {
+	VersionPattern string
+	Pattern        *regexp.Regexp // overrides VersionPattern if non-nil
+
+	CalVerFormat     string
+	Scheme           Scheme // overrides CalVerFormat and VersionPattern if non-nil
+	DateTimeFormat   string
+	DateTimeLocation *time.Location
+	MinSupported     string
+	Render           RenderOptions
+	Template         *template.Template
+	Now              func() time.Time
+}
+
+// Option modifies a Config constructed by NewConfig.
+type Option func(*Config)
+
+// NewConfig returns a Config with the package defaults, independent of any
+// changes made to the package-level variables, modified by each given Option.
+func NewConfig(opts ...Option) *Config {
+	cfg := &Config{
+		VersionPattern: semverPattern,
+		DateTimeFormat: time.RFC1123,
+		Render:         defaultRenderOptions,
+		Now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithVersionPattern sets the regular expression used to validate and identify
+// the components of a semantic version string.
+func WithVersionPattern(pattern string) Option {
+	return func(cfg *Config) { cfg.VersionPattern = pattern }
+}
+
+// WithPattern sets a compiled regular expression used to validate and identify
+// the components of a version string, overriding VersionPattern. Components are
+// identified by the named capture groups "major", "minor", "patch",
+// "prerelease", and "buildmetadata" (as in the pattern suggested by
+// https://semver.org); components without a group are zero. For example, a
+// four-part scheme may capture its fourth component as build metadata:
+//
+//	^(?P<major>\d+)\.(?P<minor>\d+)\.(?P<patch>\d+)\.(?P<buildmetadata>\d+)$
+//
+// If the pattern has no named groups, components are identified by position,
+// as with VersionPattern, and a pattern with fewer than three capture groups
+// is rejected by Parse. Since the pattern belongs to the Config, programs may
+// validate several schemes concurrently without modifying VersionPattern.
+func WithPattern(re *regexp.Regexp) Option {
+	return func(cfg *Config) { cfg.Pattern = re }
+}
+
+// WithCalVer sets the calendar versioning format used instead of semantic
+// versions (see CalVerFormat).
+func WithCalVer(format string) Option {
+	return func(cfg *Config) { cfg.CalVerFormat = format }
+}
+
+// WithScheme sets the versioning scheme, overriding the calendar version format
+// and version pattern (see VersionScheme).
+func WithScheme(s Scheme) Option {
+	return func(cfg *Config) { cfg.Scheme = s }
+}
+
+// WithDateTimeFormat sets the format used to write the date-time of a change.
+func WithDateTimeFormat(format string) Option {
+	return func(cfg *Config) { cfg.DateTimeFormat = format }
+}
+
+// WithLocation sets the time zone in which date-times are written.
+func WithLocation(loc *time.Location) Option {
+	return func(cfg *Config) { cfg.DateTimeLocation = loc }
+}
+
+// WithMinSupported sets the oldest supported version; changes with lower
+// precedence are marked "[UNSUPPORTED]" when rendered (see MinSupported).
+func WithMinSupported(version string) Option {
+	return func(cfg *Config) { cfg.MinSupported = version }
+}
+
+// WithRenderOptions sets the layout used to format each change.
+func WithRenderOptions(opts RenderOptions) Option {
+	return func(cfg *Config) { cfg.Render = opts }
+}
+
+// WithTemplate sets the template used to format each change instead of the
+// layout defined by RenderOptions.
+func WithTemplate(t *template.Template) Option {
+	return func(cfg *Config) { cfg.Template = t }
+}
+
+// WithClock sets the function returning the current time.
+func WithClock(now func() time.Time) Option {
+	return func(cfg *Config) { cfg.Now = now }
+}
+
+// globalConfig returns a Config composed of the current values of the
+// package-level variables.
+func globalConfig() *Config {
+	return &Config{
+		VersionPattern:   VersionPattern,
+		CalVerFormat:     CalVerFormat,
+		Scheme:           VersionScheme,
+		DateTimeFormat:   DateTimeFormat,
+		DateTimeLocation: DateTimeLocation,
+		MinSupported:     minSupported,
+		Render:           DefaultRenderOptions,
+		Template:         ChangeTemplate,
+		Now:              Now,
+	}
+}
+
+// Parse returns the components of the given semantic version string, or an
+// error if it is invalid according to cfg.Pattern, if defined, or else
+// cfg.VersionPattern, or if cfg.VersionPattern has fewer than three capture
+// groups.
+func (cfg *Config) Parse(version string) (Semver, error) {
+	if nil != cfg.Pattern {
+		return parseNamed(cfg.Pattern, version)
+	}
+	if semverPattern == cfg.VersionPattern {
+		return ParseSemver(version)
+	}
+	re, err := compilePattern(cfg.VersionPattern)
+	if nil != err {
+		return Semver{}, err
+	}
+	return parseRegexp(re, version)
+}
+
+// parseNamed returns the components of the given version string identified by
+// the named capture groups of re (see WithPattern), or by position if re has no
+// named groups. Returns an error if the version string is not matched by re,
+// or if re has neither named groups nor at least three capture groups.
+func parseNamed(re *regexp.Regexp, version string) (Semver, error) {
+	sub := re.FindStringSubmatch(version)
+	if nil == sub {
+		return Semver{}, fmt.Errorf("invalid version: %s", version)
+	}
+	names := re.SubexpNames()
+	named := false
+	for _, name := range names {
+		named = named || "" != name
+	}
+	if !named {
+		return parseRegexp(re, version)
+	}
+	var v Semver
+	for i, name := range names {
+		var n *uint
+		switch name {
+		case "major":
+			n = &v.Major
+		case "minor":
+			n = &v.Minor
+		case "patch":
+			n = &v.Patch
+		case "prerelease":
+			v.Prerelease = sub[i]
+		case "buildmetadata":
+			v.Metadata = sub[i]
+		}
+		if nil != n && "" != sub[i] {
+			u, err := strconv.ParseUint(sub[i], 10, 0)
+			if nil != err {
+				return Semver{}, fmt.Errorf("invalid version: %s: %v", version, err)
+			}
+			*n = uint(u)
+		}
+	}
+	return v, nil
+}
+
+// Validate returns an error if the given version string is invalid according
+// to the versioning scheme of cfg (Scheme if defined, else CalVerFormat if
+// defined, else VersionPattern).
+func (cfg *Config) Validate(version string) error {
+	if nil != cfg.Scheme {
+		return cfg.Scheme.Validate(version)
+	}
+	if "" != cfg.CalVerFormat {
+		_, err := ParseCalVer(cfg.CalVerFormat, version)
+		return err
+	}
+	_, err := cfg.Parse(version)
+	return err
+}
+
+// compare compares two valid version strings according to the versioning
+// scheme of cfg.
+func (cfg *Config) compare(a, b string) int {
+	switch {
+	case nil != cfg.Scheme:
+		return cfg.Scheme.Compare(a, b)
+	case "" != cfg.CalVerFormat:
+		return CalVerScheme(cfg.CalVerFormat).Compare(a, b)
+	}
+	av, _ := cfg.Parse(a)
+	bv, _ := cfg.Parse(b)
+	return av.Compare(bv)
+}
+
+// isUnsupported returns true if and only if cfg.MinSupported is defined and
+// the given valid version string has lower precedence.
+func (cfg *Config) isUnsupported(version string) bool {
+	if "" == cfg.MinSupported {
+		return false
+	}
+	if nil != cfg.Scheme {
+		return nil == cfg.Scheme.Validate(version) &&
+			nil == cfg.Scheme.Validate(cfg.MinSupported) &&
+			cfg.Scheme.Compare(version, cfg.MinSupported) < 0
+	}
+	if "" != cfg.CalVerFormat {
+		a, aerr := ParseCalVer(cfg.CalVerFormat, version)
+		b, berr := ParseCalVer(cfg.CalVerFormat, cfg.MinSupported)
+		return nil == aerr && nil == berr && a.Compare(b) < 0
+	}
+	a, aerr := cfg.Parse(version)
+	b, berr := cfg.Parse(cfg.MinSupported)
+	return nil == aerr && nil == berr && a.Compare(b) < 0
+}
+
+// IsValid returns true if and only if the given version string is valid
+// according to the versioning scheme of cfg.
+func (cfg *Config) IsValid(version string) bool {
+	return nil == cfg.Validate(version)
+}
+
+// Layout returns a formatted, multi-line string describing Change c, as
+// written by WriteChange, or an error if c is invalid.
+func (cfg *Config) Layout(c *Change) (string, error) {
+	b := strings.Builder{}
+	if _, err := cfg.WriteChange(&b, c); nil != err {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// WriteChange writes to w the formatted description of Change c using
+// cfg.Template, if defined, or else cfg.Render. It returns the number of bytes
+// written and any error encountered, including an invalid version string.
+func (cfg *Config) WriteChange(w io.Writer, c *Change) (int64, error) {
+	cw := &countWriter{w: w}
+	err := c.stream(cw, w, cfg)
+	if nil == err {
+		err = cw.err
+	}
+	return cw.n, err
+}
+
+// FprintChangeLog writes to w each of the entries in the given changelog,
+// formatted as by WriteChange and separated by blank lines. Returns the first
+// error encountered.
+func (cfg *Config) FprintChangeLog(w io.Writer, log []Change) error {
+	for i := range log {
+		if _, err := cfg.WriteChange(w, &log[i]); nil != err {
+			return err
+		}
+		if _, err := io.WriteString(w, "\n"); nil != err {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package version_test
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestConfig(t *testing.T) {
+	cfg := version.NewConfig(version.WithVersionPattern(`^(\d+)\.(\d+)\.(\d+)$`))
+	if v, err := cfg.Parse("1.2.3"); nil != err || 2 != v.Minor {
+		t.Errorf("Parse(1.2.3) = %v, %v", v, err)
+	}
+	if cfg.IsValid("1.2.3-rc.1") {
+		t.Errorf("IsValid(1.2.3-rc.1) = true with custom pattern")
+	}
+	if !version.IsValid("1.2.3-rc.1") {
+		t.Errorf("custom Config modified package VersionPattern")
+	}
+
+	cal := version.NewConfig(version.WithCalVer("YYYY.0M.MICRO"))
+	if !cal.IsValid("2020.03.1") || cal.IsValid("1.2.3") {
+		t.Errorf("CalVer Config validated incorrectly")
+	}
+
+	c := version.Change{Version: "1.2", Date: "2020-03-09"}
+	if _, err := version.NewConfig().Layout(&c); nil == err {
+		t.Errorf("Layout(invalid) = nil error")
+	}
+
+	defer func(opts version.RenderOptions) { version.DefaultRenderOptions = opts }(version.DefaultRenderOptions)
+	if !reflect.DeepEqual(version.NewConfig().Render, version.DefaultRenderOptions) {
+		t.Errorf("NewConfig().Render = %+v, want DefaultRenderOptions", version.NewConfig().Render)
+	}
+	version.DefaultRenderOptions.Width = 40
+	if 40 == version.NewConfig().Render.Width {
+		t.Errorf("NewConfig().Render follows changes to DefaultRenderOptions")
+	}
+}
+
+func ExampleNewConfig() {
+	cfg := version.NewConfig(
+		version.WithDateTimeFormat("2006-01-02"),
+		version.WithRenderOptions(version.RenderOptions{
+			Width: 40, Rule: '=', Margin: 1, Indent: 2,
+		}),
+		version.WithClock(func() time.Time {
+			return time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+		}),
+	)
+	cfg.FprintChangeLog(os.Stdout, []version.Change{
+		{Version: "0.1.0", Date: "2020-03-09", Description: []string{"first"}},
+		{Version: "0.2.0", Date: "2020-03-20", Description: []string{"second"}},
+	})
+
+	// Output:
+	// ========================================
+	//  version 0.1.0               2020-03-09
+	// ========================================
+	//   first
+	//
+	// ========================================
+	//  version 0.2.0               2020-03-20
+	// ========================================
+	//   second
+}
+
+func TestConfigPattern(t *testing.T) {
+	fourPart := version.NewConfig(version.WithPattern(regexp.MustCompile(
+		`^(?P<major>\d+)\.(?P<minor>\d+)\.(?P<patch>\d+)\.(?P<buildmetadata>\d+)$`)))
+	strict := version.NewConfig()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !fourPart.IsValid("1.2.3.4") || fourPart.IsValid("1.2.3") {
+				t.Error("four-part pattern: wrong validation")
+			}
+			if strict.IsValid("1.2.3.4") || !strict.IsValid("1.2.3") {
+				t.Error("strict pattern: wrong validation")
+			}
+		}()
+	}
+	wg.Wait()
+
+	v, err := fourPart.Parse("10.2.3.4567")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if want := (version.Semver{Major: 10, Minor: 2, Patch: 3, Metadata: "4567"}); v != want {
+		t.Errorf("Parse = %+v, want %+v", v, want)
+	}
+
+	for _, pattern := range []string{`^(\d+)\.(\d+)$`, `^(\d+)\.(\d+)\.(\d+)$`} {
+		cfg := version.NewConfig(version.WithVersionPattern(pattern))
+		if _, err := cfg.Parse("1.2"); nil == err {
+			t.Errorf("Parse(1.2) with pattern %s: expected error", pattern)
+		}
+	}
+	short := version.NewConfig(version.WithPattern(regexp.MustCompile(`^(\d+)\.(\d+)$`)))
+	if _, err := short.Parse("1.2"); nil == err {
+		t.Error("Parse(1.2) with 2 unnamed groups: expected error")
+	}
+	huge := version.NewConfig(version.WithVersionPattern(`^(\d+)\.(\d+)\.(\d+)$`))
+	if _, err := huge.Parse("1.2.99999999999999999999"); nil == err {
+		t.Error("Parse(overflowing patch): expected error")
+	}
+}
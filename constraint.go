@@ -0,0 +1,337 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraints is a set of version requirements, such as ">= 1.2, < 3.0.0 ||
+// ^4.2", in the grammar used by github.com/Masterminds/semver (and thus Helm
+// charts and many configuration files). Construct one with NewConstraint.
+//
+// A constraint is one or more groups separated by "||", any of which must be
+// satisfied. Each group is one or more comparisons separated by commas or
+// spaces, all of which must be satisfied. A comparison is an operator followed
+// by a (possibly partial or wildcard) version:
+//
+//	=, == (or none)  equal; "1.2" and "1.2.x" mean >=1.2.0 <1.3.0
+//	!=               not equal; "!=1.2" excludes all of 1.2.x
+//	>, >=, =>        greater than (or equal to)
+//	<, <=, =<        less than (or equal to)
+//	~, ~>            patch-level changes: "~1.2.3" means >=1.2.3 <1.3.0
+//	^                compatible changes: "^1.2.3" means >=1.2.3 <2.0.0, and
+//	                 "^0.2.3" means >=0.2.3 <0.3.0
+//	A - B            inclusive range: "1.2 - 1.4.5" means >=1.2.0 <=1.4.5
+//
+// Versions may be prefixed with "v", and "x", "X", or "*" may be used in place
+// of any trailing components. A prerelease version satisfies a group only if a
+// comparison in that group names a prerelease of the same major, minor, and
+// patch version (e.g., "1.2.3-beta.2" satisfies ">=1.2.3-alpha" but not ">=1.2.0").
+type Constraints struct {
+	text   string
+	groups []constraintGroup
+}
+
+// constraintGroup is a set of comparisons that must all be satisfied.
+type constraintGroup struct {
+	text string
+	cmps []comparison
+	pre  []Semver // versions whose prereleases may satisfy the group
+}
+
+// comparison is a primitive comparison of a version with v. The operator "!<>"
+// is satisfied by versions outside of the range [v, hi).
+type comparison struct {
+	op    string
+	v, hi Semver
+}
+
+// lowest is less than every semantic version, and any version compared to it
+// with "<" is never satisfied.
+var lowest = Semver{Prerelease: "0"}
+
+// NewConstraint parses the given constraint string (see Constraints).
+// Returns an error if the constraint is malformed.
+func NewConstraint(constraint string) (*Constraints, error) {
+	c := &Constraints{text: strings.TrimSpace(constraint)}
+	for _, text := range strings.Split(constraint, "||") {
+		g, err := parseGroup(text)
+		if nil != err {
+			return nil, fmt.Errorf("invalid constraint %q: %v", constraint, err)
+		}
+		c.groups = append(c.groups, g)
+	}
+	return c, nil
+}
+
+// String returns the constraint string from which Constraints c was parsed.
+func (c *Constraints) String() string {
+	return c.text
+}
+
+// Check returns true if and only if version v satisfies Constraints c.
+func (c *Constraints) Check(v Semver) bool {
+	for _, g := range c.groups {
+		if g.check(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Satisfies returns true if and only if the given version, in any form accepted
+// by ParseTolerant, satisfies the given constraint (see Constraints).
+// Returns an error if either the version or constraint is invalid.
+func Satisfies(version, constraint string) (bool, error) {
+	v, err := ParseTolerant(version)
+	if nil != err {
+		return false, err
+	}
+	c, err := NewConstraint(constraint)
+	if nil != err {
+		return false, err
+	}
+	return c.Check(v), nil
+}
+
+// check returns true if and only if version v satisfies every comparison in
+// constraintGroup g.
+func (g constraintGroup) check(v Semver) bool {
+	if "" != v.Prerelease {
+		allowed := false
+		for _, p := range g.pre {
+			if p.Major == v.Major && p.Minor == v.Minor && p.Patch == v.Patch {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	for _, cmp := range g.cmps {
+		if !cmp.check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// check returns true if and only if version v satisfies comparison c.
+func (c comparison) check(v Semver) bool {
+	n := v.Compare(c.v)
+	switch c.op {
+	case "=":
+		return 0 == n
+	case "!=":
+		return 0 != n
+	case ">":
+		return n > 0
+	case ">=":
+		return n >= 0
+	case "<":
+		return n < 0
+	case "<=":
+		return n <= 0
+	case "!<>":
+		return n < 0 || v.Compare(c.hi) >= 0
+	}
+	return false
+}
+
+// parseGroup parses a set of comparisons separated by commas or spaces.
+func parseGroup(text string) (constraintGroup, error) {
+	g := constraintGroup{text: strings.TrimSpace(text)}
+	fields := strings.Fields(strings.Replace(text, ",", " ", -1))
+	if 0 == len(fields) {
+		return g, fmt.Errorf("empty constraint")
+	}
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		// join an operator separated from its version by spaces
+		if op := splitOperator(field); op == field && i+1 < len(fields) {
+			i++
+			field += fields[i]
+		}
+		// hyphen range "A - B"
+		if i+2 < len(fields) && "-" == fields[i+1] {
+			lo, err := parsePartial(field)
+			if nil != err {
+				return g, err
+			}
+			hi, err := parsePartial(fields[i+2])
+			if nil != err {
+				return g, err
+			}
+			g.add(lo, expand(">=", lo))
+			g.add(hi, expand("<=", hi))
+			i += 2
+			continue
+		}
+		op := splitOperator(field)
+		p, err := parsePartial(field[len(op):])
+		if nil != err {
+			return g, err
+		}
+		g.add(p, expand(op, p))
+	}
+	return g, nil
+}
+
+// add appends the given comparisons to constraintGroup g, and permits
+// prereleases of the version of partial p if it names one.
+func (g *constraintGroup) add(p partial, cmps []comparison) {
+	g.cmps = append(g.cmps, cmps...)
+	if "" != p.v.Prerelease {
+		g.pre = append(g.pre, p.v)
+	}
+}
+
+// splitOperator returns the comparison operator prefixing s, if any.
+func splitOperator(s string) string {
+	for _, op := range []string{"==", "!=", ">=", "=>", "<=", "=<", "~>", "=", ">", "<", "~", "^"} {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// partial is a version in which trailing components may be omitted or
+// wildcards. Only the first n components of v were specified.
+type partial struct {
+	v Semver
+	n int
+}
+
+// parsePartial parses a possibly-partial version, such as "1", "v1.2.x", "*",
+// or "1.2.3-rc.1".
+func parsePartial(s string) (partial, error) {
+	var p partial
+	text := s
+	if strings.HasPrefix(s, "v") || strings.HasPrefix(s, "V") {
+		s = s[1:]
+	}
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i] // build metadata is ignored
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s, p.v.Prerelease = s[:i], s[i+1:]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return p, fmt.Errorf("invalid version %q", text)
+	}
+	comps := [3]*uint{&p.v.Major, &p.v.Minor, &p.v.Patch}
+	wild := false
+	for i, part := range parts {
+		switch part {
+		case "x", "X", "*":
+			wild = true
+			continue
+		}
+		n, err := strconv.ParseUint(part, 10, 0)
+		if nil != err || wild {
+			return p, fmt.Errorf("invalid version %q", text)
+		}
+		*comps[i] = uint(n)
+		p.n = i + 1
+	}
+	if "" != p.v.Prerelease {
+		if 3 != p.n {
+			return p, fmt.Errorf("invalid version %q: prerelease requires major, minor, and patch", text)
+		}
+		if _, err := ParseSemver(p.v.String()); nil != err {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+// next returns the lowest version (including prereleases) greater than every
+// version matched by partial p, or false if p matches all versions.
+func (p partial) next() (Semver, bool) {
+	switch p.n {
+	case 1:
+		return Semver{Major: p.v.Major + 1, Prerelease: "0"}, true
+	case 2:
+		return Semver{Major: p.v.Major, Minor: p.v.Minor + 1, Prerelease: "0"}, true
+	}
+	return Semver{}, false
+}
+
+// expand returns the primitive comparisons equivalent to the given operator
+// applied to partial p.
+func expand(op string, p partial) []comparison {
+	lo := p.v
+	hi, bounded := p.next()
+	switch op {
+	case "", "=", "==":
+		if 3 == p.n {
+			return []comparison{{op: "=", v: lo}}
+		}
+		if !bounded {
+			return nil // any version
+		}
+		return []comparison{{op: ">=", v: lo}, {op: "<", v: hi}}
+	case "!=":
+		if 3 == p.n {
+			return []comparison{{op: "!=", v: lo}}
+		}
+		if !bounded {
+			return []comparison{{op: "<", v: lowest}} // no version
+		}
+		return []comparison{{op: "!<>", v: lo, hi: hi}}
+	case ">":
+		if 3 == p.n {
+			return []comparison{{op: ">", v: lo}}
+		}
+		if !bounded {
+			return []comparison{{op: "<", v: lowest}}
+		}
+		return []comparison{{op: ">=", v: hi}}
+	case ">=", "=>":
+		if 0 == p.n {
+			return nil
+		}
+		return []comparison{{op: ">=", v: lo}}
+	case "<":
+		if 0 == p.n {
+			return []comparison{{op: "<", v: lowest}}
+		}
+		if p.n < 3 {
+			lo.Prerelease = "0" // exclude prereleases of the lower bound
+		}
+		return []comparison{{op: "<", v: lo}}
+	case "<=", "=<":
+		if 3 == p.n {
+			return []comparison{{op: "<=", v: lo}}
+		}
+		if !bounded {
+			return nil
+		}
+		return []comparison{{op: "<", v: hi}}
+	case "~", "~>":
+		if 0 == p.n {
+			return nil
+		}
+		if p.n > 1 {
+			hi = Semver{Major: lo.Major, Minor: lo.Minor + 1, Prerelease: "0"}
+		}
+		return []comparison{{op: ">=", v: lo}, {op: "<", v: hi}}
+	case "^":
+		switch {
+		case 0 == p.n:
+			return nil
+		case lo.Major > 0 || 1 == p.n:
+			hi = Semver{Major: lo.Major + 1, Prerelease: "0"}
+		case lo.Minor > 0 || 2 == p.n:
+			hi = Semver{Minor: lo.Minor + 1, Prerelease: "0"}
+		default:
+			hi = Semver{Patch: lo.Patch + 1, Prerelease: "0"}
+		}
+		return []comparison{{op: ">=", v: lo}, {op: "<", v: hi}}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestConstraints(t *testing.T) {
+	for _, tc := range []struct {
+		constraint string
+		yes, no    []string
+	}{
+		{"1.2.3", []string{"1.2.3", "v1.2.3+build"}, []string{"1.2.4", "1.2.3-rc.1"}},
+		{"= 1.2", []string{"1.2.0", "1.2.99"}, []string{"1.3.0", "1.1.9", "1.2.0-rc.1"}},
+		{"!=4.1", []string{"4.0.9", "4.2.0", "5.0.0"}, []string{"4.1.0", "4.1.7"}},
+		{"!=4.1.2", []string{"4.1.1", "4.1.3"}, []string{"4.1.2"}},
+		{">= 1.2, < 3.0.0 || >= 4.2.3", []string{"1.2.0", "2.9.9", "4.2.3", "9.0.0"}, []string{"1.1.9", "3.0.0", "4.2.2"}},
+		{">=1.2 <2", []string{"1.9.9"}, []string{"2.0.0", "2.0.0-rc.1"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{"<=2.3", []string{"2.3.9", "0.1.0"}, []string{"2.4.0"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~>1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"^1.2", []string{"1.2.0", "1.9.0"}, []string{"2.0.0", "1.1.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"1.2 - 1.4.5", []string{"1.2.0", "1.4.5"}, []string{"1.4.6", "1.1.0"}},
+		{"1.2.3 - 2.3", []string{"2.3.9"}, []string{"2.4.0"}},
+		{"2.x", []string{"2.0.0", "2.99.0"}, []string{"3.0.0"}},
+		{"*", []string{"0.0.0", "100.0.0"}, []string{"1.0.0-rc.1"}},
+		{">=1.2.3-alpha", []string{"1.2.3-beta.2", "1.2.3", "1.3.0"}, []string{"1.3.0-rc.1", "1.2.3-0"}},
+		{"v1.x || v3.*", []string{"1.5.0", "3.1.0"}, []string{"2.0.0"}},
+	} {
+		c, err := version.NewConstraint(tc.constraint)
+		if nil != err {
+			t.Errorf("NewConstraint(%q) = %v", tc.constraint, err)
+			continue
+		}
+		for _, v := range tc.yes {
+			if ok, _ := version.Satisfies(v, tc.constraint); !ok {
+				t.Errorf("%q does not satisfy %q", v, c)
+			}
+		}
+		for _, v := range tc.no {
+			if ok, _ := version.Satisfies(v, tc.constraint); ok {
+				t.Errorf("%q satisfies %q", v, c)
+			}
+		}
+	}
+	for _, s := range []string{"", ">=1.2 ||", "1.2.3.4", "!1.2", "1.x.3", "1.2-rc.1", ">=bogus"} {
+		if _, err := version.NewConstraint(s); nil == err {
+			t.Errorf("NewConstraint(%q) = nil error", s)
+		}
+	}
+}
+
+func TestConstraintSets(t *testing.T) {
+	must := func(s string) *version.Constraints {
+		c, err := version.NewConstraint(s)
+		if nil != err {
+			t.Fatal(err)
+		}
+		return c
+	}
+	for _, tc := range []struct {
+		a, b    string
+		overlap bool
+	}{
+		{">=1.2 <2", "^1.5", true},
+		{">=1.2 <2", "^2.1", false},
+		{"~1.2.3", "1.2.x", true},
+		{"<1.2.3", ">1.2.2", false},
+		{"<=1.2.3", ">=1.2.3", true},
+		{"!=1.2.3", "1.2.3", false},
+		{"!=1.2", "1.2.7 || 1.3.0", true},
+		{"<1.2.3", ">1.2.3-rc.1", false},
+		{"<1.2.3", ">=1.2.3-rc.1", false},
+		{">=1.2.3-rc.1", "1.2.3-rc.5", true},
+		{"<1.2.3-rc.2", ">1.2.3-rc.1", true},
+		{"<1.2.3-rc.1.0", ">1.2.3-rc.1", false},
+		{"^1 || ^3", "^2 || >=3.5", true},
+		{"*", "<0", false},
+	} {
+		a, b := must(tc.a), must(tc.b)
+		if got := a.Overlaps(b); got != tc.overlap {
+			t.Errorf("%q overlaps %q = %t, want %t", tc.a, tc.b, got, tc.overlap)
+		}
+		if got := b.Overlaps(a); got != tc.overlap {
+			t.Errorf("%q overlaps %q = %t, want %t", tc.b, tc.a, got, tc.overlap)
+		}
+		// the string of the intersection must parse to an equivalent constraint,
+		// except that it may permit more prereleases
+		if s := a.Intersect(b).String(); !strings.Contains(s, "-") &&
+			must(s).IsEmpty() == tc.overlap {
+			t.Errorf("intersection %q: IsEmpty() = %t", s, !tc.overlap)
+		}
+	}
+
+	u := must("^1.2").Union(must("^2.1"))
+	if "^1.2 || ^2.1" != u.String() {
+		t.Errorf("union = %q", u)
+	}
+	for v, want := range map[string]bool{"1.3.0": true, "2.1.0": true, "2.0.0": false} {
+		sv, _ := version.ParseSemver(v)
+		if got := u.Check(sv); got != want {
+			t.Errorf("union Check(%s) = %t, want %t", v, got, want)
+		}
+	}
+}
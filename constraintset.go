@@ -0,0 +1,182 @@
+package version
+
+import "strings"
+
+// Intersect returns the Constraints satisfied by exactly the versions that
+// satisfy both c and o. Its String combines the groups of each, such as
+// ">=1.2 <2, ^1.5" for ">=1.2 <2" and "^1.5". The combined string is equivalent
+// except for prereleases: when parsed, it permits the prereleases named by
+// either c or o, whereas the intersection permits only those named by both.
+func (c *Constraints) Intersect(o *Constraints) *Constraints {
+	r := &Constraints{}
+	var text []string
+	for _, a := range c.groups {
+		for _, b := range o.groups {
+			g := constraintGroup{
+				text: a.text + ", " + b.text,
+				cmps: append(append([]comparison(nil), a.cmps...), b.cmps...),
+			}
+			// a prerelease satisfies g only if it is permitted by both a and b
+			for _, p := range a.pre {
+				if b.permits(p) {
+					g.pre = append(g.pre, p)
+				}
+			}
+			r.groups = append(r.groups, g)
+			text = append(text, g.text)
+		}
+	}
+	r.text = strings.Join(text, " || ")
+	return r
+}
+
+// Union returns the Constraints satisfied by exactly the versions that satisfy
+// either c or o. Its String is the equivalent constraint, such as
+// "^1.2 || ^2.0" for "^1.2" and "^2.0".
+func (c *Constraints) Union(o *Constraints) *Constraints {
+	return &Constraints{
+		text:   c.text + " || " + o.text,
+		groups: append(append([]constraintGroup(nil), c.groups...), o.groups...),
+	}
+}
+
+// IsEmpty returns true if and only if no version satisfies Constraints c, such
+// as ">=2 <1" or "^1.2, ^2".
+func (c *Constraints) IsEmpty() bool {
+	for _, g := range c.groups {
+		if !g.isEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Overlaps returns true if and only if some version satisfies both c and o,
+// i.e., the requirements do not conflict. For example, ">=1.2 <2" overlaps
+// "^1.5", but not "^2.1".
+func (c *Constraints) Overlaps(o *Constraints) bool {
+	return !c.Intersect(o).IsEmpty()
+}
+
+// permits returns true if and only if constraintGroup g permits prereleases of
+// the major, minor, and patch version of v.
+func (g constraintGroup) permits(v Semver) bool {
+	for _, p := range g.pre {
+		if p.Major == v.Major && p.Minor == v.Minor && p.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+// bound is an endpoint of an interval of versions. A bound with inf set is
+// unbounded in the direction of the interval.
+type bound struct {
+	v   Semver
+	inc bool // v itself is included
+	inf bool
+}
+
+// interval is a contiguous range of versions between lo and hi.
+type interval struct {
+	lo, hi bound
+}
+
+// intervals returns the disjoint intervals of versions satisfying comparison c.
+func (c comparison) intervals() []interval {
+	all := bound{inf: true}
+	switch c.op {
+	case "=":
+		return []interval{{bound{v: c.v, inc: true}, bound{v: c.v, inc: true}}}
+	case "!=":
+		return []interval{{all, bound{v: c.v}}, {bound{v: c.v}, all}}
+	case ">":
+		return []interval{{bound{v: c.v}, all}}
+	case ">=":
+		return []interval{{bound{v: c.v, inc: true}, all}}
+	case "<":
+		return []interval{{all, bound{v: c.v}}}
+	case "<=":
+		return []interval{{all, bound{v: c.v, inc: true}}}
+	case "!<>":
+		return []interval{{all, bound{v: c.v}}, {bound{v: c.hi, inc: true}, all}}
+	}
+	return nil
+}
+
+// intersect returns the intersection of intervals a and b, which may be empty.
+func (a interval) intersect(b interval) interval {
+	lo, hi := a.lo, a.hi
+	if !b.lo.inf {
+		if n := b.lo.v.Compare(lo.v); lo.inf || n > 0 || 0 == n && !b.lo.inc {
+			lo = b.lo
+		}
+	}
+	if !b.hi.inf {
+		if n := b.hi.v.Compare(hi.v); hi.inf || n < 0 || 0 == n && !b.hi.inc {
+			hi = b.hi
+		}
+	}
+	return interval{lo, hi}
+}
+
+// contains returns true if and only if version v is within interval i.
+func (i interval) contains(v Semver) bool {
+	if !i.lo.inf {
+		if n := v.Compare(i.lo.v); n < 0 || 0 == n && !i.lo.inc {
+			return false
+		}
+	}
+	if !i.hi.inf {
+		if n := v.Compare(i.hi.v); n > 0 || 0 == n && !i.hi.inc {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmpty returns true if and only if no version satisfies constraintGroup g:
+// i.e., the intervals satisfying every comparison contain neither a release
+// version nor a prerelease permitted by g.
+func (g constraintGroup) isEmpty() bool {
+	set := []interval{{bound{inf: true}, bound{inf: true}}}
+	for _, c := range g.cmps {
+		var next []interval
+		for _, a := range set {
+			for _, b := range c.intervals() {
+				next = append(next, a.intersect(b))
+			}
+		}
+		set = next
+	}
+	for _, i := range set {
+		// the lowest release version within the lower bound of i
+		v := Semver{}
+		if !i.lo.inf {
+			v = Semver{Major: i.lo.v.Major, Minor: i.lo.v.Minor, Patch: i.lo.v.Patch}
+			if "" == i.lo.v.Prerelease && !i.lo.inc {
+				v.Patch++
+			}
+		}
+		if i.contains(v) {
+			return false
+		}
+		// the lowest permitted prerelease within the lower bound of i
+		for _, p := range g.pre {
+			v := Semver{Major: p.Major, Minor: p.Minor, Patch: p.Patch, Prerelease: "0"}
+			if !i.lo.inf && v.Compare(i.lo.v) <= 0 {
+				v.Prerelease = i.lo.v.Prerelease
+				if "" == v.Prerelease || v.Compare(i.lo.v) != 0 {
+					continue // i begins after every prerelease of p
+				}
+				if !i.lo.inc {
+					v.Prerelease += ".0" // least prerelease greater than i.lo.v
+				}
+			}
+			if i.contains(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package version
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// csvHeader lists the columns written by WriteCSV.
+var csvHeader = []string{"version", "date", "title", "categories", "description"}
+
+// WriteCSV encodes to given io.Writer w the given Change entries as CSV, with
+// one row per entry (in the given order) beneath a header row naming the
+// columns: version, date, title, categories, and description. Dates are written
+// as YYYY-MM-DD where recognized. The categories column lists the distinct
+// categories (e.g., "Added, Fixed") prefixing description lines, as described
+// by WriteMarkdown, and the description column contains every description line
+// separated by newlines.
+func WriteCSV(w io.Writer, log []Change) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); nil != err {
+		return err
+	}
+	for _, c := range log {
+		date := c.Date
+		if t := ParseDate(c.Date); nil != t {
+			date = t.Format("2006-01-02")
+		}
+		var cats []string
+		for _, name := range markdownCategories {
+			for _, line := range c.Description {
+				if strings.HasPrefix(line, name+": ") {
+					cats = append(cats, name)
+					break
+				}
+			}
+		}
+		row := []string{
+			c.Version, date, c.Title,
+			strings.Join(cats, ", "), strings.Join(c.Description, "\n"),
+		}
+		if err := cw.Write(row); nil != err {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
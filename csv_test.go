@@ -0,0 +1,19 @@
+package version_test
+
+import (
+	"os"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleWriteCSV() {
+	version.WriteCSV(os.Stdout, []version.Change{
+		{Version: "1.0.0", Date: "Mon, 09 Mar 2020 17:45:23 UTC", Title: "First, at last"},
+		{Version: "1.1.0", Description: []string{"Fixed: a bug", "Added: a \"feature\""}},
+	})
+	// Output:
+	// version,date,title,categories,description
+	// 1.0.0,2020-03-09,"First, at last",,
+	// 1.1.0,,,"Added, Fixed","Fixed: a bug
+	// Added: a ""feature"""
+}
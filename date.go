@@ -0,0 +1,202 @@
+package version
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// dateFormat and timeFormat list the layouts of the date and time components
+// recognized by ParseDate.
+var (
+	dateFormat = []string{
+		`2006 January 2`,
+		`2006-January-2`,
+		`2006 Jan 2`,
+		`2006-Jan-2`,
+		`2006-1-2`,
+		`2006 1 2`,
+		`1-2-2006`,
+		`1/2/2006`,
+		`01-02-2006`,
+		`01/02/2006`,
+
+		`January 2, 2006`,
+		`Jan 2, 2006`,
+
+		`06 January 2`,
+		`06-January-2`,
+		`06 Jan 2`,
+		`06-Jan-2`,
+		`06-1-2`,
+		`06 1 2`,
+		`1-2-06`,
+		`1/2/06`,
+		`01-02-06`,
+		`01/02/06`,
+
+		`January 2, 06`,
+		`Jan 2, 06`,
+
+		`2 January 2006`,
+		`2 Jan 2006`,
+		`2-Jan-2006`,
+	}
+	timeFormat = []string{
+		`15:04:05`,
+
+		`03:04:05PM`,
+		`03:04:05pm`,
+		`3:04:05PM`,
+		`3:04:05pm`,
+
+		`15:04`,
+
+		`03:04PM`,
+		`03:04pm`,
+		`3:04PM`,
+		`3:04pm`,
+	}
+)
+
+// dateLayouts lists every layout attempted by ParseDate, in order.
+var dateLayouts = func() []string {
+	var layouts []string
+	for _, fd := range dateFormat {
+		for _, ft := range timeFormat {
+			layouts = append(layouts, fd+" "+ft, ft+" "+fd)
+		}
+	}
+	layouts = append(layouts, dateFormat...)
+	return append(layouts,
+		time.ANSIC,       // "Mon Jan _2 15:04:05 2006"
+		time.UnixDate,    // "Mon Jan _2 15:04:05 MST 2006"
+		time.RubyDate,    // "Mon Jan 02 15:04:05 -0700 2006"
+		time.RFC822,      // "02 Jan 06 15:04 MST"
+		time.RFC822Z,     // "02 Jan 06 15:04 -0700" // RFC822 with numeric zone
+		time.RFC850,      // "Monday, 02-Jan-06 15:04:05 MST"
+		time.RFC1123,     // "Mon, 02 Jan 2006 15:04:05 MST"
+		time.RFC1123Z,    // "Mon, 02 Jan 2006 15:04:05 -0700" // RFC1123 with numeric zone
+		time.RFC3339,     // "2006-01-02T15:04:05Z07:00"
+		time.RFC3339Nano, // "2006-01-02T15:04:05.999999999Z07:00"
+	)
+}()
+
+// dateLayoutOrder lists the indices of dateLayouts in the order they are
+// attempted. Each layout that recognizes a date-time is moved to the front, so
+// that the dates of a changelog, which typically share one or a few layouts, are
+// each recognized after few attempts. The list is replaced rather than modified
+// in place, so that a copy may be iterated without holding the lock.
+var dateLayoutOrder = struct {
+	sync.Mutex
+	list []int
+}{list: func() []int {
+	list := make([]int, len(dateLayouts))
+	for i := range list {
+		list[i] = i
+	}
+	return list
+}()}
+
+// promoteDateLayout moves layout index i to the front of dateLayoutOrder.
+func promoteDateLayout(i int) {
+	dateLayoutOrder.Lock()
+	defer dateLayoutOrder.Unlock()
+	old := dateLayoutOrder.list
+	if old[0] == i {
+		return
+	}
+	list := make([]int, 0, len(old))
+	list = append(list, i)
+	for _, j := range old {
+		if j != i {
+			list = append(list, j)
+		}
+	}
+	dateLayoutOrder.list = list
+}
+
+// ParseDate parses the given date-time string. It attempts every permutation of
+// each dateFormat and timeFormat pair (in either order), returning the first
+// successfully-parsed time.Time object. If none of the pairs are successful,
+// each dateFormat (ignoring timeFormat) is then attempted. Finally, each of the
+// standard formats provided by the time package are attempted. Layouts that
+// recently recognized a date-time are attempted first, so a string matching
+// several layouts (e.g., "03-04-05") is parsed like the dates preceding it.
+// The returned time is in DateTimeLocation (see its documentation).
+// Returns nil if the string is not recognized; use ParseDateErr for the reason.
+func ParseDate(date string) *time.Time {
+	return parseDateIn(date, DateTimeLocation)
+}
+
+// ParseDateErr parses the given date-time string as described by ParseDate,
+// returning an error if it is not recognized by any of the supported layouts.
+// The error describes why the layout that matched the longest prefix of the
+// string failed.
+func ParseDateErr(date string) (time.Time, error) {
+	return parseDateErr(date, DateTimeLocation)
+}
+
+// parseDateIn parses the given date-time string as described by ParseDate,
+// using the given location instead of DateTimeLocation.
+func parseDateIn(date string, loc *time.Location) *time.Time {
+	if t, err := parseDateErr(date, loc); nil == err {
+		return &t
+	}
+	return nil
+}
+
+// parseDateErr parses the given date-time string as described by ParseDateErr,
+// using the given location instead of DateTimeLocation.
+func parseDateErr(date string, loc *time.Location) (time.Time, error) {
+	if "" == date {
+		return time.Time{}, fmt.Errorf("empty date-time")
+	}
+	in := loc
+	if nil == in {
+		in = time.UTC
+	}
+	parse := func(layout string) (time.Time, error) {
+		// the location applies only to layouts without zone information
+		t, err := time.ParseInLocation(layout, date, in)
+		if nil == err && nil != loc {
+			t = t.In(loc)
+		}
+		return t, err
+	}
+
+	dateLayoutOrder.Lock()
+	order := dateLayoutOrder.list
+	dateLayoutOrder.Unlock()
+
+	var closest *time.ParseError
+	closestIndex := 0
+	for _, i := range order {
+		t, err := parse(dateLayouts[i])
+		if nil == err {
+			promoteDateLayout(i)
+			return t, nil
+		}
+		// remember the error of the layout that consumed the most input, or the
+		// first such layout in dateLayouts, independent of the order attempted
+		if pe, ok := err.(*time.ParseError); ok {
+			if nil == closest || len(pe.ValueElem) < len(closest.ValueElem) ||
+				len(pe.ValueElem) == len(closest.ValueElem) && i < closestIndex {
+				closest, closestIndex = pe, i
+			}
+		}
+	}
+	// attempt to translate localized month names into English
+	for _, l := range DateLocales {
+		if s := l.translate(date); s != date {
+			if t, err := parseDateErr(s, loc); nil == err {
+				return t, nil
+			}
+		}
+	}
+	if nil != closest {
+		return time.Time{}, fmt.Errorf("unrecognized date-time %q: "+
+			"closest layout %q: %w", date, closest.Layout, closest)
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date-time %q", date)
+}
@@ -0,0 +1,81 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestParseDateErr(t *testing.T) {
+	want := time.Date(2020, time.March, 9, 17, 45, 23, 0, time.UTC)
+	for i := 0; i < 2; i++ { // second pass attempts recent layouts first
+		for _, s := range []string{
+			"20-March-9 17:45:23", "2020-3-9 5:45:23pm", "17:45:23 03/09/2020",
+			"Mon, 09 Mar 2020 17:45:23 UTC", "2020-03-09T17:45:23Z",
+		} {
+			got, err := version.ParseDateErr(s)
+			if nil != err || !got.Equal(want) {
+				t.Errorf("ParseDateErr(%q) = %v, %v, want %v", s, got, err, want)
+			}
+			if p := version.ParseDate(s); nil == p || !p.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", s, p, want)
+			}
+		}
+	}
+	// an explicit zone is kept unless DateTimeLocation is defined
+	zoned := "Mon, 09 Mar 2020 10:45:23 -0700"
+	if got, err := version.ParseDateErr(zoned); nil != err || !got.Equal(want) {
+		t.Errorf("ParseDateErr(%q) = %v, %v, want %v", zoned, got, err, want)
+	} else if _, off := got.Zone(); -7*60*60 != off {
+		t.Errorf("ParseDateErr(%q) zone offset = %d, want -25200", zoned, off)
+	}
+	defer func(loc *time.Location) { version.DateTimeLocation = loc }(version.DateTimeLocation)
+	version.DateTimeLocation = time.FixedZone("EST", -5*60*60)
+	if got, _ := version.ParseDateErr(zoned); got.Location() != version.DateTimeLocation {
+		t.Errorf("ParseDateErr(%q) location = %v, want EST", zoned, got.Location())
+	}
+	version.DateTimeLocation = nil
+
+	// an ambiguous date is parsed like the dates preceding it
+	version.ParseDate("12-31-05")
+	if got := version.ParseDate("03-04-05"); nil == got || time.March != got.Month() || 2005 != got.Year() {
+		t.Errorf("ParseDate(03-04-05) after M-D-YY date = %v, want 2005-03-04", got)
+	}
+	version.ParseDate("31-12-25")
+	if got := version.ParseDate("03-04-05"); nil == got || time.April != got.Month() || 2003 != got.Year() {
+		t.Errorf("ParseDate(03-04-05) after YY-M-D date = %v, want 2003-04-05", got)
+	}
+
+	for s, reason := range map[string]string{
+		"":           "empty",
+		"2020-13-09": "month out of range",
+		"yesterday":  "unrecognized",
+	} {
+		if _, err := version.ParseDateErr(s); nil == err || !strings.Contains(err.Error(), reason) {
+			t.Errorf("ParseDateErr(%q) error = %v, want %q", s, err, reason)
+		}
+		if nil != version.ParseDate(s) {
+			t.Errorf("ParseDate(%q) != nil", s)
+		}
+	}
+}
+
+func BenchmarkParseDate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		version.ParseDate("Mon, 09 Mar 2020 17:45:23 UTC")
+	}
+}
+
+func BenchmarkParseDateDistinct(b *testing.B) {
+	dates := make([]string, 10000)
+	t := time.Date(2020, time.March, 9, 17, 45, 23, 0, time.UTC)
+	for i := range dates {
+		dates[i] = t.Add(time.Duration(i) * 37 * time.Hour).Format(time.RFC1123Z)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		version.ParseDate(dates[i%len(dates)])
+	}
+}
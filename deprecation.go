@@ -0,0 +1,53 @@
+package version
+
+// Deprecation describes a feature deprecated by a Change. If Feature is empty,
+// the version of the Change itself is deprecated (e.g., users should upgrade).
+type Deprecation struct {
+	Feature string `json:"feature,omitempty"` // deprecated feature
+	Removal string `json:"removal,omitempty"` // version in which it is removed
+}
+
+// String returns a description of Deprecation d, such as
+// "Config.Legacy (removal in 2.0.0)".
+func (d Deprecation) String() string {
+	s := d.Feature
+	if "" == s {
+		s = "this version"
+	}
+	if "" != d.Removal {
+		s += " (removal in " + d.Removal + ")"
+	}
+	return s
+}
+
+// IsDeprecated returns true if and only if an entry in ChangeLog with the given
+// version deprecates the version itself (see Deprecation). Versions are matched
+// by precedence, so build metadata is ignored.
+// It panics if any of the version strings are invalid.
+func IsDeprecated(version string) bool {
+	for _, c := range ChangeLog {
+		if 0 == compareVersions(c.Version, version) {
+			for _, d := range c.Deprecations {
+				if "" == d.Feature {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// DeprecationsSince returns each entry in ChangeLog with deprecations whose
+// version has higher precedence than the given current version, in ChangeLog
+// order. Upgrade notes can be generated from these to warn users moving from
+// the given version to the latest.
+// It panics if any of the version strings are invalid.
+func DeprecationsSince(current string) []Change {
+	var changes []Change
+	for _, c := range ChangeLog {
+		if len(c.Deprecations) > 0 && compareVersions(c.Version, current) > 0 {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
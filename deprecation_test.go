@@ -0,0 +1,44 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestDeprecationsSince(t *testing.T) {
+	defer func(log []version.Change) { version.ChangeLog = log }(version.ChangeLog)
+	version.ChangeLog = []version.Change{
+		{Version: "1.0.0", Deprecations: []version.Deprecation{{}}},
+		{Version: "1.1.0", Deprecations: []version.Deprecation{{Feature: "Foo"}}},
+		{Version: "1.2.0"},
+		{Version: "1.3.0", Deprecations: []version.Deprecation{{Feature: "Bar", Removal: "2.0.0"}}},
+	}
+	got := version.DeprecationsSince("1.0.0")
+	if 2 != len(got) || "1.1.0" != got[0].Version || "1.3.0" != got[1].Version {
+		t.Errorf("DeprecationsSince(1.0.0) = %v", got)
+	}
+	if !version.IsDeprecated("1.0.0+build.1") || version.IsDeprecated("1.1.0") {
+		t.Errorf("IsDeprecated reported incorrectly")
+	}
+}
+
+func ExampleDeprecation() {
+	c := version.Change{
+		Version:     "1.3.0",
+		Description: []string{"add Config.Modern"},
+		Deprecations: []version.Deprecation{
+			{Feature: "Config.Legacy", Removal: "2.0.0"},
+		},
+	}
+	fmt.Print(c.Layout(version.RenderOptions{Width: 30, Rule: '-', Margin: 1, Indent: 2, Hang: 2}))
+
+	// Output:
+	// ------------------------------
+	//  version 1.3.0
+	// ------------------------------
+	//   add Config.Modern
+	//   Deprecated:
+	//     Config.Legacy (removal in 2.0.0)
+}
@@ -0,0 +1,57 @@
+package version
+
+// DiffKind identifies the most significant component that differs between two
+// semantic versions.
+type DiffKind int
+
+// Constants defining each DiffKind, in increasing order of significance.
+const (
+	DiffNone       DiffKind = iota // versions are identical
+	DiffMetadata                   // only build metadata differs
+	DiffPrerelease                 // prerelease differs
+	DiffPatch                      // patch component differs
+	DiffMinor                      // minor component differs
+	DiffMajor                      // major component differs
+)
+
+// String returns the name of the component identified by DiffKind k.
+func (k DiffKind) String() string {
+	switch k {
+	case DiffNone:
+		return "none"
+	case DiffMetadata:
+		return "metadata"
+	case DiffPrerelease:
+		return "prerelease"
+	case DiffPatch:
+		return "patch"
+	case DiffMinor:
+		return "minor"
+	case DiffMajor:
+		return "major"
+	}
+	return "unknown"
+}
+
+// Diff returns the most significant component that differs between two
+// semantic version strings, regardless of which is greater. For example, Diff
+// of "1.2.3" and "1.3.0" is DiffMinor, which callers may use to decide whether
+// an automatic update is safe.
+// It panics if either of the given version strings is invalid.
+func Diff(a, b string) DiffKind {
+	amaj, amin, apat, apre, ameta := Parse(a)
+	bmaj, bmin, bpat, bpre, bmeta := Parse(b)
+	switch {
+	case amaj != bmaj:
+		return DiffMajor
+	case amin != bmin:
+		return DiffMinor
+	case apat != bpat:
+		return DiffPatch
+	case apre != bpre:
+		return DiffPrerelease
+	case ameta != bmeta:
+		return DiffMetadata
+	}
+	return DiffNone
+}
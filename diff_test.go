@@ -0,0 +1,25 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want version.DiffKind
+	}{
+		{"1.2.3", "1.2.3", version.DiffNone},
+		{"1.2.3+a", "1.2.3+b", version.DiffMetadata},
+		{"1.2.3-rc.1", "1.2.3", version.DiffPrerelease},
+		{"1.2.3", "1.2.4-rc.1+x", version.DiffPatch},
+		{"1.3.0", "1.2.3", version.DiffMinor},
+		{"1.2.3", "2.2.3", version.DiffMajor},
+	} {
+		if got := version.Diff(tc.a, tc.b); got != tc.want {
+			t.Errorf("Diff(%q, %q) = %s, want %s", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ChangeLogDigest returns a stable, hex-encoded SHA-256 digest of the entries
+// in ChangeLog (see Digest). Builds may embed it so that deployments can detect
+// when two executables claiming the same version carry different release notes.
+func ChangeLogDigest() string {
+	return Digest(ChangeLog)
+}
+
+// Digest returns a stable, hex-encoded SHA-256 digest of the given entries.
+// Entries are canonicalized before hashing so that insignificant differences do
+// not affect the digest: leading and trailing whitespace is removed from each
+// string, and dates are rewritten in UTC as RFC 3339 where they can be parsed.
+// Entry order and every other field are significant.
+func Digest(log []Change) string {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	for _, c := range log {
+		enc.Encode(canonical(c))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// canonical returns a copy of Change c in the canonical form used by Digest.
+func canonical(c Change) Change {
+	trim := func(list []string) []string {
+		if 0 == len(list) {
+			return nil
+		}
+		out := make([]string, len(list))
+		for i, s := range list {
+			out[i] = strings.TrimSpace(s)
+		}
+		return out
+	}
+	c.Package = strings.TrimSpace(c.Package)
+	c.Version = strings.TrimSpace(c.Version)
+	c.Title = strings.TrimSpace(c.Title)
+	c.Date = strings.TrimSpace(c.Date)
+	if t, err := parseDateErr(c.Date, time.UTC); nil == err {
+		c.Date = t.UTC().Format(time.RFC3339)
+	}
+	c.Description = trim(c.Description)
+	c.Authors = trim(c.Authors)
+	c.Links = trim(c.Links)
+	if len(c.Artifacts) > 0 {
+		c.Artifacts = append([]Artifact(nil), c.Artifacts...)
+		for i := range c.Artifacts {
+			c.Artifacts[i].SHA256 = strings.ToLower(c.Artifacts[i].SHA256)
+		}
+	}
+	return c
+}
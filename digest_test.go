@@ -0,0 +1,34 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestDigest(t *testing.T) {
+	a := []version.Change{{
+		Version:     "1.0.0",
+		Date:        "2020-03-09",
+		Description: []string{"initial release"},
+	}}
+	b := []version.Change{{
+		Version:     " 1.0.0",
+		Date:        "Mar 9, 2020",
+		Description: []string{"initial release  "},
+	}}
+	c := []version.Change{{
+		Version:     "1.0.0",
+		Date:        "2020-03-09",
+		Description: []string{"initial release!"},
+	}}
+	if version.Digest(a) != version.Digest(b) {
+		t.Errorf("Digest differs for equivalent changelogs")
+	}
+	if version.Digest(a) == version.Digest(c) {
+		t.Errorf("Digest equal for different changelogs")
+	}
+	if got := len(version.ChangeLogDigest()); 64 != got {
+		t.Errorf("len(ChangeLogDigest()) = %d, want 64", got)
+	}
+}
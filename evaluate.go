@@ -0,0 +1,194 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Evaluate returns the value of the given boolean version expression, such as:
+//
+//	1.4.2 >= 1.2 && 1.4.2 < 2.0.0
+//	!(v1.0.0-rc.1 == 1.0.0) || (2 > 1.9.9 && 1.0.0 != 1.0.1)
+//
+// An expression compares versions, in any form accepted by ParseLoose, using
+// the operators ==, !=, <, <=, >, and >=, which compare precedence as defined
+// by Compare. Comparisons are combined with the logical operators ! (not),
+// && (and), and || (or), in decreasing order of precedence, and grouped with
+// parentheses.
+// Returns an error if the expression is malformed or contains an invalid
+// version.
+func Evaluate(expr string) (bool, error) {
+	tokens, err := tokenizeExpr(expr)
+	if nil != err {
+		return false, fmt.Errorf("evaluate %q: %v", expr, err)
+	}
+	p := exprParser{tokens: tokens}
+	val, err := p.or()
+	if nil == err && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if nil != err {
+		return false, fmt.Errorf("evaluate %q: %v", expr, err)
+	}
+	return val, nil
+}
+
+// exprOperators lists the operators recognized by tokenizeExpr, with each
+// operator preceding any operator that is its prefix.
+var exprOperators = []string{
+	"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")",
+}
+
+// tokenizeExpr splits the given expression into operators and operands.
+func tokenizeExpr(expr string) ([]string, error) {
+	var tokens []string
+	s := expr
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if "" == s {
+			return tokens, nil
+		}
+		op := ""
+		for _, o := range exprOperators {
+			if strings.HasPrefix(s, o) {
+				op = o
+				break
+			}
+		}
+		if "" != op {
+			tokens = append(tokens, op)
+			s = s[len(op):]
+			continue
+		}
+		n := strings.IndexFunc(s, func(r rune) bool {
+			return !('0' <= r && r <= '9' || 'a' <= r && r <= 'z' ||
+				'A' <= r && r <= 'Z' || '.' == r || '-' == r || '+' == r)
+		})
+		switch {
+		case 0 == n:
+			return nil, fmt.Errorf("unexpected %q", s[:1])
+		case n < 0:
+			n = len(s)
+		}
+		tokens = append(tokens, s[:n])
+		s = s[n:]
+	}
+}
+
+// exprParser evaluates a tokenized expression by recursive descent.
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+// peek returns the next token, or an empty string at the end of input.
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// or evaluates: and { "||" and }
+func (p *exprParser) or() (bool, error) {
+	val, err := p.and()
+	for nil == err && "||" == p.peek() {
+		p.pos++
+		var rhs bool
+		rhs, err = p.and()
+		val = val || rhs
+	}
+	return val, err
+}
+
+// and evaluates: unary { "&&" unary }
+func (p *exprParser) and() (bool, error) {
+	val, err := p.unary()
+	for nil == err && "&&" == p.peek() {
+		p.pos++
+		var rhs bool
+		rhs, err = p.unary()
+		val = val && rhs
+	}
+	return val, err
+}
+
+// unary evaluates: "!" unary | "(" or ")" | comparison
+func (p *exprParser) unary() (bool, error) {
+	switch p.peek() {
+	case "!":
+		p.pos++
+		val, err := p.unary()
+		return !val, err
+	case "(":
+		p.pos++
+		val, err := p.or()
+		if nil != err {
+			return false, err
+		}
+		if ")" != p.peek() {
+			return false, p.expected("\")\"")
+		}
+		p.pos++
+		return val, nil
+	}
+	return p.comparison()
+}
+
+// comparison evaluates: operand ("==" | "!=" | "<" | "<=" | ">" | ">=") operand
+func (p *exprParser) comparison() (bool, error) {
+	a, err := p.operand()
+	if nil != err {
+		return false, err
+	}
+	op := p.peek()
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		p.pos++
+	default:
+		return false, p.expected("comparison operator")
+	}
+	b, err := p.operand()
+	if nil != err {
+		return false, err
+	}
+	c := a.Compare(b)
+	switch op {
+	case "==":
+		return 0 == c, nil
+	case "!=":
+		return 0 != c, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+// operand parses a version.
+func (p *exprParser) operand() (Semver, error) {
+	tok := p.peek()
+	for _, o := range exprOperators {
+		if tok == o {
+			return Semver{}, p.expected("version")
+		}
+	}
+	if "" == tok {
+		return Semver{}, p.expected("version")
+	}
+	p.pos++
+	v, _, err := ParseLoose(tok)
+	return v, err
+}
+
+// expected returns an error describing the next token, which is not the
+// expected token described by what.
+func (p *exprParser) expected(what string) error {
+	if tok := p.peek(); "" != tok {
+		return fmt.Errorf("expected %s, found %q", what, tok)
+	}
+	return fmt.Errorf("expected %s at end of expression", what)
+}
@@ -0,0 +1,43 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleEvaluate() {
+	ok, err := version.Evaluate("1.4.2 >= 1.2 && 1.4.2 < 2.0.0")
+	fmt.Println(ok, err)
+	// Output:
+	// true <nil>
+}
+
+func TestEvaluate(t *testing.T) {
+	for expr, want := range map[string]bool{
+		"1.0.0 == 1":                              true,
+		"1.0.0 == 1.0.0+build.5":                  true,
+		"1.0.0-rc.1 < 1.0.0":                      true,
+		"v2 > 1.9.9":                              true,
+		"1.0.0 != 1.0.0":                          false,
+		"!(1.0.0 <= 0.9)":                         true,
+		"1 > 2 || 2 > 1 && 3 > 2":                 true,
+		"(1 > 2 || 2 > 1) && 3 < 2":               false,
+		"!1 > 2 && !(2 < 1)":                      true,
+		"1.4.2 >= 1.2 && 1.4.2 < 2.0.0 || 0 == 1": true,
+	} {
+		got, err := version.Evaluate(expr)
+		if nil != err || got != want {
+			t.Errorf("Evaluate(%q) = %t, %v; want %t", expr, got, err, want)
+		}
+	}
+	for _, expr := range []string{
+		"", "1.0.0", "1.0.0 =", "1.0.0 = 1.0.0", "(1 < 2", "1 < 2)", "1 < 2 &&",
+		"1 < 2 & 2 < 3", "x.y.z < 1", "1 < < 2", "1 < 2 3",
+	} {
+		if _, err := version.Evaluate(expr); nil == err {
+			t.Errorf("Evaluate(%q): expected error", expr)
+		}
+	}
+}
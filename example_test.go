@@ -2,6 +2,8 @@ package version_test
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ardnew/version"
 )
@@ -70,3 +72,138 @@ func Example() {
 	// !! set version to "0.1.4"
 	//
 }
+
+func ExampleParseTolerant() {
+	v, err := version.ParseTolerant(" v1.2.3-rc.1\n")
+	fmt.Println(v, err)
+
+	_, err = version.ParseTolerant("v1.2")
+	fmt.Println(err)
+
+	// Output:
+	// 1.2.3-rc.1 <nil>
+	// invalid version: "v1.2"
+}
+
+func ExampleParseLoose() {
+	for _, s := range []string{"1", "v1.2-rc.1", "1.2.3"} {
+		v, inf, _ := version.ParseLoose(s)
+		fmt.Printf("%-10s %-10s minor inferred: %-5t patch inferred: %t\n", s, v,
+			0 != inf&version.InferredMinor, 0 != inf&version.InferredPatch)
+	}
+
+	// Output:
+	// 1          1.0.0      minor inferred: true  patch inferred: true
+	// v1.2-rc.1  1.2.0-rc.1 minor inferred: false patch inferred: true
+	// 1.2.3      1.2.3      minor inferred: false patch inferred: false
+}
+
+func ExampleCoerce() {
+	for _, s := range []string{"release-1.4.0-build77", "MyApp 2.3", "v3", "none"} {
+		v, ok := version.Coerce(s)
+		fmt.Printf("%q: %s %t\n", s, v, ok)
+	}
+
+	// Output:
+	// "release-1.4.0-build77": 1.4.0 true
+	// "MyApp 2.3": 2.3.0 true
+	// "v3": 3.0.0 true
+	// "none": 0.0.0 false
+}
+
+func ExampleChange_Layout() {
+	c := version.Change{
+		Package:     "mypkg",
+		Version:     "0.1.0",
+		Title:       "Formal Test",
+		Date:        "Feb 26, 2020",
+		Description: []string{"update user manual"},
+	}
+	opts := version.DefaultRenderOptions
+	opts.Width = 70
+	opts.Rule = '='
+	opts.Indent = 4
+	opts.QuoteTitle = false
+	fmt.Print(c.Layout(opts))
+
+	// Output:
+	// ======================================================================
+	//  mypkg version 0.1.0 - Formal Test      Wed, 26 Feb 2020 00:00:00 UTC
+	// ======================================================================
+	//     update user manual
+}
+
+func ExampleColorMode() {
+	c := version.Change{
+		Version:     "2.0.0",
+		Date:        "Feb 26, 2020",
+		Description: []string{"BREAKING CHANGE: remove Dude", "fix bug: Sweet"},
+	}
+	opts := version.DefaultRenderOptions
+	opts.Color = version.ColorAlways
+	fmt.Printf("%q\n", c.Layout(opts))
+
+	// Output:
+	// "――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――\n version \x1b[1m2.0.0\x1b[0m                                    \x1b[2mWed, 26 Feb 2020 00:00:00 UTC\x1b[0m\n――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――――\n  \x1b[31mBREAKING CHANGE: remove Dude\x1b[0m\n  fix bug: Sweet\n"
+}
+
+func ExampleRenderOptions_wrap() {
+	c := version.Change{
+		Version: "1.0.0",
+		Description: []string{
+			"- add a feature whose description is far too long to fit on a single line of the box",
+			"- fix bug",
+		},
+	}
+	opts := version.DefaultRenderOptions
+	opts.Width = 40
+	opts.Rule = '-'
+	fmt.Print(c.Layout(opts))
+
+	// Output:
+	// ----------------------------------------
+	//  version 1.0.0
+	// ----------------------------------------
+	//   - add a feature whose description is
+	//     far too long to fit on a single line
+	//     of the box
+	//   - fix bug
+}
+
+func ExampleRenderOptions_ascii() {
+	c := version.Change{Version: "1.0.0", Description: []string{"initial commit"}}
+	opts := version.DefaultRenderOptions
+	opts.Width = 30
+	opts.ASCII = true
+	fmt.Print(c.Layout(opts))
+
+	// Output:
+	// ------------------------------
+	//  version 1.0.0
+	// ------------------------------
+	//   initial commit
+}
+
+func ExampleRenderOptions_location() {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	opts := version.DefaultRenderOptions
+	opts.Location = tokyo
+	for _, date := range []string{"Feb 26, 2020 17:45", "2020-02-26T17:45:00Z"} {
+		c := version.Change{Version: "1.0.0", Date: date}
+		fmt.Println(strings.Split(c.Layout(opts), "\n")[1])
+	}
+
+	// Output:
+	//  version 1.0.0                                    Wed, 26 Feb 2020 17:45:00 JST
+	//  version 1.0.0                                    Thu, 27 Feb 2020 02:45:00 JST
+}
+
+func ExampleRecent() {
+	for _, c := range version.Recent(2) {
+		fmt.Println(c.Version)
+	}
+
+	// Output:
+	// 0.1.0+fqt
+	// 0.2.0-beta+red
+}
@@ -0,0 +1,34 @@
+package version
+
+import (
+	"expvar"
+	"sync"
+)
+
+// ExpvarName is the name under which PublishExpvar registers its variable.
+var ExpvarName = "version"
+
+var publishExpvar sync.Once
+
+// PublishExpvar registers the package version, commit, and build date with
+// package expvar, so that they are reported by the /debug/vars handler.
+// The values are evaluated each time the variable is read; if the version is
+// invalid, the reason is reported under the key "error" instead of panicking.
+// Calling PublishExpvar more than once has no additional effect, and nothing is
+// published if a variable named ExpvarName is already registered.
+func PublishExpvar() {
+	publishExpvar.Do(func() {
+		if nil != expvar.Get(ExpvarName) {
+			return
+		}
+		expvar.Publish(ExpvarName, expvar.Func(func() interface{} {
+			m := map[string]string{"commit": Commit, "date": BuildDate}
+			if ver, err := versionString(); nil != err {
+				m["error"] = err.Error()
+			} else {
+				m["version"] = ver
+			}
+			return m
+		}))
+	})
+}
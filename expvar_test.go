@@ -0,0 +1,38 @@
+package version_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestPublishExpvar(t *testing.T) {
+	version.PublishExpvar()
+	version.PublishExpvar() // must not panic on reuse
+
+	v := expvar.Get(version.ExpvarName)
+	if nil == v {
+		t.Fatalf("expvar %q not published", version.ExpvarName)
+	}
+	var m map[string]string
+	if err := json.Unmarshal([]byte(v.String()), &m); nil != err {
+		t.Fatal(err)
+	}
+	if m["version"] != version.String() {
+		t.Errorf("version = %q, want %q", m["version"], version.String())
+	}
+
+	defer func(log version.History, v version.Semver) {
+		version.ChangeLog, version.Version = log, v
+	}(version.ChangeLog, version.Version)
+	version.ChangeLog, version.Version = version.History{{Version: "bogus"}}, version.Semver{}
+	m = nil
+	if err := json.Unmarshal([]byte(v.String()), &m); nil != err {
+		t.Fatal(err)
+	}
+	if "" == m["error"] || "" != m["version"] {
+		t.Errorf("invalid version: expvar = %v, want error", m)
+	}
+}
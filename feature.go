@@ -0,0 +1,78 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// features contains the version in which each feature registered with Since
+// became available, keyed by feature name.
+var features = struct {
+	sync.RWMutex
+	m map[string]string
+}{m: map[string]string{}}
+
+// Since declares that the named feature is available since the given version,
+// replacing any previous declaration of the same feature. Features are listed
+// in the rendered entry of ChangeLog with that version.
+// It is safe to call Since from multiple goroutines.
+// Returns an error if feature is empty or the version is invalid.
+func Since(feature, version string) error {
+	if "" == feature {
+		return errors.New("since: empty feature name")
+	}
+	if err := validate(version); nil != err {
+		return fmt.Errorf("since %s: %v", feature, err)
+	}
+	features.Lock()
+	defer features.Unlock()
+	features.m[feature] = version
+	return nil
+}
+
+// Enabled returns true if and only if the named feature was declared with Since
+// and the package version (see String) has equal or higher precedence than the
+// version in which the feature became available.
+func Enabled(feature string) bool {
+	features.RLock()
+	since, ok := features.m[feature]
+	features.RUnlock()
+	if !ok {
+		return false
+	}
+	ver, err := versionString()
+	if nil != err || "" == ver {
+		return false
+	}
+	return compareVersions(ver, since) >= 0
+}
+
+// RemoveFeature removes the declaration of the named feature made with Since,
+// if it exists.
+func RemoveFeature(feature string) {
+	features.Lock()
+	defer features.Unlock()
+	delete(features.m, feature)
+}
+
+// featuresSince returns the sorted names of the features declared with Since
+// whose version has precedence equal to the given version, according to the
+// versioning scheme of cfg. Features whose version is invalid in that scheme
+// are ignored.
+func featuresSince(cfg *Config, version string) []string {
+	if nil != cfg.Validate(version) {
+		return nil
+	}
+	features.RLock()
+	defer features.RUnlock()
+	var list []string
+	for name, since := range features.m {
+		if nil == cfg.Validate(since) && 0 == cfg.compare(since, version) {
+			list = append(list, name)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
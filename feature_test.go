@@ -0,0 +1,42 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestEnabled(t *testing.T) {
+	defer func(v version.Semver) { version.Version = v }(version.Version)
+	version.Set("9.1.0")
+	defer version.RemoveFeature("fast-path")
+	defer version.RemoveFeature("teleport")
+	if err := version.Since("fast-path", "9.1.0"); nil != err {
+		t.Fatal(err)
+	}
+	if err := version.Since("teleport", "10.0.0-rc.1"); nil != err {
+		t.Fatal(err)
+	}
+	if err := version.Since("bogus", "9.1"); nil == err {
+		t.Errorf("Since(invalid) = nil error")
+	}
+	if !version.Enabled("fast-path") || version.Enabled("teleport") || version.Enabled("unknown") {
+		t.Errorf("Enabled reported incorrectly")
+	}
+	c := version.Change{Version: "9.1.0+build.1"}
+	if s := c.Layout(version.RenderOptions{Width: 40}); !strings.Contains(s, "Features: fast-path") {
+		t.Errorf("Layout() = %q, want features", s)
+	}
+
+	// features are compared using the scheme of the Config rendering the change
+	cfg := version.NewConfig(version.WithCalVer("YYYY.0M.MICRO"))
+	if s, err := cfg.Layout(&version.Change{Version: "2020.03.1"}); nil != err || strings.Contains(s, "Features") {
+		t.Errorf("Config.Layout(calver) = %q, %v", s, err)
+	}
+
+	version.RemoveFeature("fast-path")
+	if version.Enabled("fast-path") {
+		t.Errorf("Enabled(removed feature) = true")
+	}
+}
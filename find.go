@@ -0,0 +1,98 @@
+package version
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// TextMatch is a semantic version string found in free-form text by FindAll.
+type TextMatch struct {
+	Text    string // version string as written, without any "v" prefix
+	Version Semver // components of Text
+	Offset  int    // byte offset of Text from the beginning of the input
+	Line    int    // line number of Text, beginning with 1
+	Column  int    // byte offset of Text from the beginning of its line, plus 1
+}
+
+// candidatePattern matches text that may contain a semantic version string.
+// Candidates are validated, and their boundaries checked, by findInLine.
+var candidatePattern = regexp.MustCompile(
+	`\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?`)
+
+// FindAll returns every semantic version string found in the text read from r,
+// such as command output, log files, or HTML, in order of appearance, so that
+// tools may discover the version reported by another program. A version may be
+// prefixed with "v" or "V", but must not otherwise be adjacent to letters,
+// digits, or dots; e.g., no version is found in "1.2.3.4" or "abc1.2.3".
+// Trailing dots and hyphens, such as the period ending a sentence, are ignored.
+// Returns the versions found before any error reading from r.
+func FindAll(r io.Reader) ([]TextMatch, error) {
+	var found []TextMatch
+	br := bufio.NewReader(r)
+	offset := 0
+	for line := 1; ; line++ {
+		s, err := br.ReadString('\n')
+		found = append(found, findInLine(s, offset, line)...)
+		offset += len(s)
+		if io.EOF == err {
+			return found, nil
+		}
+		if nil != err {
+			return found, err
+		}
+	}
+}
+
+// findInLine returns each semantic version string found in the given line of
+// text, which begins at the given byte offset of the input.
+func findInLine(s string, offset, line int) []TextMatch {
+	isAlnum := func(c byte) bool {
+		return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+	}
+	var found []TextMatch
+	for _, m := range candidatePattern.FindAllStringIndex(s, -1) {
+		start, end := m[0], m[1]
+		// check the left boundary, permitting a "v" prefix
+		if i := start - 1; i >= 0 {
+			if 'v' == s[i] || 'V' == s[i] {
+				i--
+			} else if '-' == s[i] {
+				i = -1 // e.g., "app-1.2.3"
+			}
+			if i >= 0 && (isAlnum(s[i]) || '.' == s[i]) {
+				continue
+			}
+		}
+		// ignore trailing punctuation, then check the right boundary
+		for end > start && ('.' == s[end-1] || '-' == s[end-1]) {
+			end--
+		}
+		if end < len(s) && (isAlnum(s[end]) ||
+			'.' == s[end] && end+1 < len(s) && isAlnum(s[end+1])) {
+			continue
+		}
+		text := s[start:end]
+		v, ok := scanSemver(text)
+		if !ok {
+			// fall back to the major, minor, and patch components alone
+			i := strings.IndexAny(text, "-+")
+			if i < 0 {
+				continue
+			}
+			if v, ok = scanSemver(text[:i]); !ok {
+				continue
+			}
+			text = text[:i]
+		}
+		found = append(found, TextMatch{
+			Text:    text,
+			Version: v,
+			Offset:  offset + start,
+			Line:    line,
+			Column:  start + 1,
+		})
+	}
+	return found
+}
@@ -0,0 +1,48 @@
+package version_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleFindAll() {
+	out := "go version go1.14.2 linux/amd64\n" +
+		"myapp v2.0.0-rc.1+build.7 (commit abc1.2.3)\n" +
+		"<td>1.2.3</td> upgraded to 1.3.0.\n"
+	found, _ := version.FindAll(strings.NewReader(out))
+	for _, m := range found {
+		fmt.Printf("%d:%d %s\n", m.Line, m.Column, m.Text)
+	}
+	// Output:
+	// 2:8 2.0.0-rc.1+build.7
+	// 3:5 1.2.3
+	// 3:28 1.3.0
+}
+
+func TestFindAll(t *testing.T) {
+	for text, want := range map[string][]string{
+		"1.2.3.4":                     nil,
+		"01.2.3 x1.2.3 1.2.3a":        nil,
+		"myapp-1.2.3-linux":           {"1.2.3-linux"},
+		"released 1.2.3-01 and 4.5.6": {"1.2.3", "4.5.6"},
+		"(1.0.0), [V2.0.0]; 3.0.0-":   {"1.0.0", "2.0.0", "3.0.0"},
+	} {
+		found, err := version.FindAll(strings.NewReader(text))
+		if nil != err {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, m := range found {
+			got = append(got, m.Text)
+			if text[m.Offset:m.Offset+len(m.Text)] != m.Text {
+				t.Errorf("%q: wrong offset %d for %q", text, m.Offset, m.Text)
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("FindAll(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package version
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Formatter writes version details, changes, and changelogs in a particular
+// output format. Formatters are registered by name with RegisterFormatter, so
+// that output formats are interchangeable and third parties may provide their
+// own.
+type Formatter interface {
+	FormatVersion(w io.Writer, d Details) error
+	FormatChange(w io.Writer, c *Change) error
+	FormatChangeLog(w io.Writer, log []Change) error
+}
+
+// Names of the built-in Formatters.
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+)
+
+// formatters contains every registered Formatter, keyed by name.
+var formatters = struct {
+	sync.RWMutex
+	m map[string]Formatter
+}{m: map[string]Formatter{
+	FormatText:     textFormatter{},
+	FormatMarkdown: markdownFormatter{},
+	FormatJSON:     jsonFormatter{},
+	FormatHTML:     htmlFormatter{},
+	FormatCSV:      csvFormatter{},
+}}
+
+// RegisterFormatter registers Formatter f with the given name, replacing any
+// previous registration (including the built-in Formatters).
+// It is safe to call RegisterFormatter from multiple goroutines.
+// Returns an error if name is empty or f is nil.
+func RegisterFormatter(name string, f Formatter) error {
+	if "" == name {
+		return errors.New("register formatter: empty name")
+	}
+	if nil == f {
+		return fmt.Errorf("register formatter %s: nil Formatter", name)
+	}
+	formatters.Lock()
+	defer formatters.Unlock()
+	formatters.m[name] = f
+	return nil
+}
+
+// UnregisterFormatter removes the Formatter registered with the given name, if
+// any (including the built-in Formatters).
+// It is safe to call UnregisterFormatter from multiple goroutines.
+func UnregisterFormatter(name string) {
+	formatters.Lock()
+	defer formatters.Unlock()
+	delete(formatters.m, name)
+}
+
+// LookupFormatter returns the Formatter registered with the given name.
+// Returns false if no such Formatter is registered.
+func LookupFormatter(name string) (Formatter, bool) {
+	formatters.RLock()
+	defer formatters.RUnlock()
+	f, ok := formatters.m[name]
+	return f, ok
+}
+
+// Formatters returns the sorted names of every registered Formatter.
+func Formatters() []string {
+	formatters.RLock()
+	defer formatters.RUnlock()
+	list := make([]string, 0, len(formatters.m))
+	for name := range formatters.m {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// String returns the descriptive version string written by
+// FprintPackageVersion, such as "mypkg version 1.2.3".
+func (d Details) String() string {
+	b := strings.Builder{}
+	b.WriteString(d.Package)
+	if "" != d.Version {
+		if b.Len() > 0 {
+			b.WriteRune(' ')
+		}
+		b.WriteString("version ")
+		b.WriteString(d.Version)
+	}
+	return b.String()
+}
+
+// textFormatter writes the plain-text layout of FprintPackageVersion and
+// FprintChangeLog.
+type textFormatter struct{}
+
+func (textFormatter) FormatVersion(w io.Writer, d Details) error {
+	if s := d.String(); "" != s {
+		_, err := fmt.Fprintln(w, s)
+		return err
+	}
+	return nil
+}
+
+func (textFormatter) FormatChange(w io.Writer, c *Change) error {
+	_, err := c.WriteTo(w)
+	return err
+}
+
+func (textFormatter) FormatChangeLog(w io.Writer, log []Change) error {
+	return globalConfig().FprintChangeLog(w, log)
+}
+
+// markdownFormatter writes the keepachangelog format of WriteMarkdown.
+type markdownFormatter struct{}
+
+func (markdownFormatter) FormatVersion(w io.Writer, d Details) error {
+	return textFormatter{}.FormatVersion(w, d)
+}
+
+func (markdownFormatter) FormatChange(w io.Writer, c *Change) error {
+	b := bufio.NewWriter(w)
+	writeMarkdownEntry(b, c, 2)
+	return b.Flush()
+}
+
+func (markdownFormatter) FormatChangeLog(w io.Writer, log []Change) error {
+	return WriteMarkdown(w, log)
+}
+
+// jsonFormatter writes indented JSON.
+type jsonFormatter struct{}
+
+func (jsonFormatter) encode(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (f jsonFormatter) FormatVersion(w io.Writer, d Details) error {
+	return f.encode(w, d)
+}
+
+func (f jsonFormatter) FormatChange(w io.Writer, c *Change) error {
+	return f.encode(w, c)
+}
+
+func (jsonFormatter) FormatChangeLog(w io.Writer, log []Change) error {
+	return WriteChangeLog(w, log)
+}
+
+// htmlFormatter writes HTML fragments suitable for embedding in a page.
+type htmlFormatter struct{}
+
+// htmlTemplate defines the HTML fragments written by htmlFormatter.
+var htmlTemplate = template.Must(template.New("html").Funcs(template.FuncMap{
+	"date": func(s string) string {
+		if t := ParseDate(s); nil != t {
+			return t.Format("2006-01-02")
+		}
+		return s
+	},
+}).Parse(`
+{{- define "version"}}<span class="version">{{.}}</span>
+{{end}}
+{{- define "change"}}<section class="change" id="v{{.Version}}">
+<h2>{{with .Package}}{{.}} {{end}}version {{.Version}}
+{{- if .Yanked}} <mark>YANKED</mark>{{end}}
+{{- with .Title}} &ndash; {{.}}{{end}}
+{{- with .Date}} <time datetime="{{date .}}">{{date .}}</time>{{end}}</h2>
+{{- with .Description}}
+<ul>
+{{- range .}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</section>
+{{end}}
+{{- define "changelog"}}<div class="changelog">
+{{range .}}{{template "change" .}}{{end -}}
+</div>
+{{end}}`))
+
+func (htmlFormatter) FormatVersion(w io.Writer, d Details) error {
+	return htmlTemplate.ExecuteTemplate(w, "version", d.String())
+}
+
+func (htmlFormatter) FormatChange(w io.Writer, c *Change) error {
+	return htmlTemplate.ExecuteTemplate(w, "change", c)
+}
+
+func (htmlFormatter) FormatChangeLog(w io.Writer, log []Change) error {
+	return htmlTemplate.ExecuteTemplate(w, "changelog", log)
+}
+
+// csvFormatter writes the comma-separated values of WriteCSV.
+type csvFormatter struct{}
+
+func (csvFormatter) FormatVersion(w io.Writer, d Details) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"package", "version", "commit", "date", "goVersion"})
+	cw.Write([]string{d.Package, d.Version, d.Commit, d.Date, d.GoVersion})
+	cw.Flush()
+	return cw.Error()
+}
+
+func (csvFormatter) FormatChange(w io.Writer, c *Change) error {
+	return WriteCSV(w, []Change{*c})
+}
+
+func (csvFormatter) FormatChangeLog(w io.Writer, log []Change) error {
+	return WriteCSV(w, log)
+}
@@ -0,0 +1,111 @@
+package version_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+// briefFormatter is a minimal third-party Formatter writing one line per
+// version.
+type briefFormatter struct{}
+
+func (briefFormatter) FormatVersion(w io.Writer, d version.Details) error {
+	_, err := fmt.Fprintf(w, "%s %s\n", d.Package, d.Version)
+	return err
+}
+
+func (briefFormatter) FormatChange(w io.Writer, c *version.Change) error {
+	_, err := fmt.Fprintf(w, "%s %q\n", c.Version, c.Title)
+	return err
+}
+
+func (f briefFormatter) FormatChangeLog(w io.Writer, log []version.Change) error {
+	for i := range log {
+		if err := f.FormatChange(w, &log[i]); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+func ExampleRegisterFormatter() {
+	if err := version.RegisterFormatter("brief", briefFormatter{}); nil != err {
+		fmt.Println(err)
+		return
+	}
+	defer version.UnregisterFormatter("brief")
+	f, _ := version.LookupFormatter("brief")
+	f.FormatChangeLog(os.Stdout, []version.Change{
+		{Version: "1.0.0", Title: "first"},
+		{Version: "1.1.0", Title: "second"},
+	})
+	// Output:
+	// 1.0.0 "first"
+	// 1.1.0 "second"
+}
+
+func TestFormatters(t *testing.T) {
+	for _, name := range []string{"text", "markdown", "json", "html", "csv"} {
+		if _, ok := version.LookupFormatter(name); !ok {
+			t.Errorf("formatter %q not registered", name)
+		}
+	}
+	if nil == version.RegisterFormatter("", briefFormatter{}) {
+		t.Error("RegisterFormatter accepted an empty name")
+	}
+	if nil == version.RegisterFormatter("nil", nil) {
+		t.Error("RegisterFormatter accepted a nil Formatter")
+	}
+	version.RegisterFormatter("scratch", briefFormatter{})
+	version.UnregisterFormatter("scratch")
+	if _, ok := version.LookupFormatter("scratch"); ok {
+		t.Error("UnregisterFormatter did not remove formatter")
+	}
+}
+
+func TestCSVFormatter(t *testing.T) {
+	f, _ := version.LookupFormatter("csv")
+	c := version.Change{Version: "1.2.3", Title: "a, b", Date: "2020-03-09"}
+	b := strings.Builder{}
+	if err := f.FormatChange(&b, &c); nil != err {
+		t.Fatal(err)
+	}
+	if want := "version,date,title,categories,description\n1.2.3,2020-03-09,\"a, b\",,\n"; b.String() != want {
+		t.Errorf("FormatChange = %q, want %q", b.String(), want)
+	}
+	b.Reset()
+	if err := f.FormatVersion(&b, version.Details{Package: "tool", Version: "1.2.3"}); nil != err {
+		t.Fatal(err)
+	}
+	if want := "package,version,commit,date,goVersion\ntool,1.2.3,,,\n"; b.String() != want {
+		t.Errorf("FormatVersion = %q, want %q", b.String(), want)
+	}
+}
+
+func TestHTMLFormatter(t *testing.T) {
+	f, _ := version.LookupFormatter("html")
+	c := version.Change{
+		Version:     "1.2.3",
+		Title:       "<script>",
+		Date:        "2020-03-09",
+		Description: []string{"fix a & b"},
+	}
+	b := strings.Builder{}
+	if err := f.FormatChange(&b, &c); nil != err {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		`id="v1.2.3"`, "&lt;script&gt;", `<time datetime="2020-03-09">`,
+		"<li>fix a &amp; b</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+}
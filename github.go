@@ -0,0 +1,118 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GitHub synchronizes Change entries with the releases of a GitHub repository.
+type GitHub struct {
+	Owner string // repository owner (user or organization)
+	Repo  string // repository name
+	Token string // personal access token; required to publish releases
+
+	// BaseURL is the root URL of the REST API. Defaults to the public API at
+	// https://api.github.com; use e.g. https://HOST/api/v3 for GitHub Enterprise.
+	BaseURL string
+
+	// Client is used to send requests. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// githubRelease is the subset of the GitHub release object used by this
+// package.
+type githubRelease struct {
+	ID          int64  `json:"id,omitempty"`
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name"`
+	Body        string `json:"body"`
+	Prerelease  bool   `json:"prerelease"`
+	Draft       bool   `json:"draft,omitempty"`
+	PublishedAt string `json:"published_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+// Publish creates a release for each of the given Change entries, or updates
+// the existing release if one is already tagged with the entry's version.
+// The release body lists each line of the entry's description.
+func (g *GitHub) Publish(ctx context.Context, changes []Change) error {
+	for _, c := range changes {
+		if !IsValid(c.Version) {
+			return fmt.Errorf("publish: invalid version: %s", c.Version)
+		}
+		_, _, _, pre, _ := Parse(c.Version)
+		rel := githubRelease{
+			TagName:    TagName(c.Version),
+			Name:       releaseName(c),
+			Body:       releaseBody(c),
+			Prerelease: "" != pre,
+		}
+		var cur githubRelease
+		err := g.do(ctx, http.MethodGet,
+			"/releases/tags/"+url.PathEscape(rel.TagName), nil, &cur)
+		switch {
+		case nil == err:
+			err = g.do(ctx, http.MethodPatch,
+				fmt.Sprintf("/releases/%d", cur.ID), &rel, nil)
+		case isNotFound(err):
+			err = g.do(ctx, http.MethodPost, "/releases", &rel, nil)
+		}
+		if nil != err {
+			return fmt.Errorf("publish %s: %w", rel.TagName, err)
+		}
+	}
+	return nil
+}
+
+// Releases returns a Change entry for each published release whose tag is a
+// valid semantic version (with optional "v" prefix), ordered from oldest to
+// newest. Draft releases are ignored.
+func (g *GitHub) Releases(ctx context.Context) ([]Change, error) {
+	var changes []Change
+	for page := 1; ; page++ {
+		var rels []githubRelease
+		err := g.do(ctx, http.MethodGet,
+			fmt.Sprintf("/releases?per_page=100&page=%d", page), nil, &rels)
+		if nil != err {
+			return nil, err
+		}
+		for _, r := range rels {
+			if r.Draft {
+				continue
+			}
+			date := r.PublishedAt
+			if "" == date {
+				date = r.CreatedAt
+			}
+			if c, ok := releaseChange(r.TagName, r.Name, date, r.Body); ok {
+				changes = append(changes, c)
+			}
+		}
+		if len(rels) < 100 {
+			break
+		}
+	}
+	// releases are listed newest first
+	for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+		changes[i], changes[j] = changes[j], changes[i]
+	}
+	return changes, nil
+}
+
+func (g *GitHub) do(ctx context.Context, method, path string, in, out interface{}) error {
+	base := g.BaseURL
+	if "" == base {
+		base = "https://api.github.com"
+	}
+	u := fmt.Sprintf("%s/repos/%s/%s%s", strings.TrimSuffix(base, "/"),
+		url.PathEscape(g.Owner), url.PathEscape(g.Repo), path)
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github+json")
+	if "" != g.Token {
+		header.Set("Authorization", "Bearer "+g.Token)
+	}
+	return doJSON(ctx, g.Client, method, u, header, in, out)
+}
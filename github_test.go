@@ -0,0 +1,99 @@
+package version_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestGitHub(t *testing.T) {
+	type release struct {
+		ID          int64  `json:"id"`
+		TagName     string `json:"tag_name"`
+		Name        string `json:"name"`
+		Body        string `json:"body"`
+		Prerelease  bool   `json:"prerelease"`
+		PublishedAt string `json:"published_at"`
+	}
+	var rels []*release // newest first, as listed by GitHub
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if "Bearer secret" != r.Header.Get("Authorization") {
+			http.Error(w, "bad credentials", http.StatusUnauthorized)
+			return
+		}
+		const prefix = "/repos/ardnew/version/releases"
+		switch p := strings.TrimPrefix(r.URL.Path, prefix); {
+		case http.MethodGet == r.Method && "" == p:
+			json.NewEncoder(w).Encode(rels)
+		case http.MethodGet == r.Method && strings.HasPrefix(p, "/tags/"):
+			for _, rel := range rels {
+				if rel.TagName == strings.TrimPrefix(p, "/tags/") {
+					json.NewEncoder(w).Encode(rel)
+					return
+				}
+			}
+			http.NotFound(w, r)
+		case http.MethodPost == r.Method:
+			rel := &release{ID: int64(len(rels) + 1), PublishedAt: "2020-03-09T17:45:23Z"}
+			json.NewDecoder(r.Body).Decode(rel)
+			rels = append([]*release{rel}, rels...)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(rel)
+		case http.MethodPatch == r.Method:
+			for _, rel := range rels {
+				if fmt.Sprintf("/%d", rel.ID) == p {
+					json.NewDecoder(r.Body).Decode(rel)
+					json.NewEncoder(w).Encode(rel)
+					return
+				}
+			}
+			http.NotFound(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	gh := &version.GitHub{
+		Owner: "ardnew", Repo: "version", Token: "secret", BaseURL: srv.URL,
+	}
+	ctx := context.Background()
+	changes := []version.Change{
+		{Version: "0.1.0", Description: []string{"initial commit"}},
+		{Version: "0.2.0-beta", Title: "Red Label", Description: []string{"add Dude"}},
+	}
+	if err := gh.Publish(ctx, changes); nil != err {
+		t.Fatal(err)
+	}
+	changes[1].Description = append(changes[1].Description, "fix Sweet")
+	if err := gh.Publish(ctx, changes[1:]); nil != err {
+		t.Fatal(err)
+	}
+	if 2 != len(rels) || !rels[0].Prerelease || "v0.2.0-beta" != rels[0].TagName {
+		t.Fatalf("unexpected releases: %+v", rels)
+	}
+
+	got, err := gh.Releases(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	for i := range changes {
+		changes[i].Date = "2020-03-09T17:45:23Z"
+	}
+	if !reflect.DeepEqual(changes, got) {
+		t.Errorf("Releases() = %+v, want %+v", got, changes)
+	}
+
+	gh.Token = ""
+	if _, err := gh.Releases(ctx); nil == err {
+		t.Error("expected error without token")
+	}
+}
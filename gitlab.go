@@ -0,0 +1,112 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GitLab synchronizes Change entries with the releases of a GitLab project.
+type GitLab struct {
+	Project string // numeric ID or full path (e.g., "group/project")
+	Token   string // personal, project, or group access token
+
+	// Ref is the branch or commit from which missing tags are created when
+	// publishing a release. If empty, each release's tag must already exist.
+	Ref string
+
+	// BaseURL is the root URL of the REST API. Defaults to the public API at
+	// https://gitlab.com/api/v4; use e.g. https://HOST/api/v4 for self-hosted
+	// instances.
+	BaseURL string
+
+	// Client is used to send requests. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// gitlabRelease is the subset of the GitLab release object used by this
+// package.
+type gitlabRelease struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Ref         string `json:"ref,omitempty"`
+	ReleasedAt  string `json:"released_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+// Publish creates a release for each of the given Change entries, or updates
+// the existing release if one is already tagged with the entry's version.
+// The release description lists each line of the entry's description.
+func (g *GitLab) Publish(ctx context.Context, changes []Change) error {
+	for _, c := range changes {
+		if !IsValid(c.Version) {
+			return fmt.Errorf("publish: invalid version: %s", c.Version)
+		}
+		rel := gitlabRelease{
+			TagName:     TagName(c.Version),
+			Name:        releaseName(c),
+			Description: releaseBody(c),
+		}
+		path := "/releases/" + url.PathEscape(rel.TagName)
+		err := g.do(ctx, http.MethodGet, path, nil, nil)
+		switch {
+		case nil == err:
+			err = g.do(ctx, http.MethodPut, path, &rel, nil)
+		case isNotFound(err):
+			rel.Ref = g.Ref
+			err = g.do(ctx, http.MethodPost, "/releases", &rel, nil)
+		}
+		if nil != err {
+			return fmt.Errorf("publish %s: %w", rel.TagName, err)
+		}
+	}
+	return nil
+}
+
+// Releases returns a Change entry for each release whose tag is a valid
+// semantic version (with optional "v" prefix), ordered from oldest to newest.
+func (g *GitLab) Releases(ctx context.Context) ([]Change, error) {
+	var changes []Change
+	for page := 1; ; page++ {
+		var rels []gitlabRelease
+		err := g.do(ctx, http.MethodGet,
+			fmt.Sprintf("/releases?per_page=100&page=%d", page), nil, &rels)
+		if nil != err {
+			return nil, err
+		}
+		for _, r := range rels {
+			date := r.ReleasedAt
+			if "" == date {
+				date = r.CreatedAt
+			}
+			if c, ok := releaseChange(r.TagName, r.Name, date, r.Description); ok {
+				changes = append(changes, c)
+			}
+		}
+		if len(rels) < 100 {
+			break
+		}
+	}
+	// releases are listed newest first
+	for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+		changes[i], changes[j] = changes[j], changes[i]
+	}
+	return changes, nil
+}
+
+func (g *GitLab) do(ctx context.Context, method, path string, in, out interface{}) error {
+	base := g.BaseURL
+	if "" == base {
+		base = "https://gitlab.com/api/v4"
+	}
+	u := fmt.Sprintf("%s/projects/%s%s", strings.TrimSuffix(base, "/"),
+		url.PathEscape(g.Project), path)
+	header := http.Header{}
+	if "" != g.Token {
+		header.Set("PRIVATE-TOKEN", g.Token)
+	}
+	return doJSON(ctx, g.Client, method, u, header, in, out)
+}
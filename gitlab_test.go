@@ -0,0 +1,94 @@
+package version_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestGitLab(t *testing.T) {
+	type release struct {
+		TagName     string `json:"tag_name"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Ref         string `json:"ref,omitempty"`
+		ReleasedAt  string `json:"released_at"`
+	}
+	var rels []*release // newest first, as listed by GitLab
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if "secret" != r.Header.Get("PRIVATE-TOKEN") {
+			http.Error(w, "401 Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		const prefix = "/projects/group%2Fproject/releases"
+		p := r.URL.EscapedPath()
+		if !strings.HasPrefix(p, prefix) {
+			http.NotFound(w, r)
+			return
+		}
+		p = strings.TrimPrefix(p, prefix)
+		switch r.Method {
+		case http.MethodGet:
+			if "" == p {
+				json.NewEncoder(w).Encode(rels)
+				return
+			}
+			fallthrough
+		case http.MethodPut:
+			for _, rel := range rels {
+				if "/"+rel.TagName == p {
+					if http.MethodPut == r.Method {
+						json.NewDecoder(r.Body).Decode(rel)
+					}
+					json.NewEncoder(w).Encode(rel)
+					return
+				}
+			}
+			http.NotFound(w, r)
+		case http.MethodPost:
+			rel := &release{ReleasedAt: "2020-02-26T00:00:00Z"}
+			json.NewDecoder(r.Body).Decode(rel)
+			if "main" != rel.Ref {
+				http.Error(w, "missing ref", http.StatusBadRequest)
+				return
+			}
+			rels = append([]*release{rel}, rels...)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(rel)
+		}
+	}))
+	defer srv.Close()
+
+	gl := &version.GitLab{
+		Project: "group/project", Token: "secret", Ref: "main", BaseURL: srv.URL,
+	}
+	ctx := context.Background()
+	changes := []version.Change{
+		{Version: "1.0.0", Title: "First", Description: []string{"initial commit"}},
+		{Version: "1.1.0", Description: []string{"add feature"}},
+	}
+	if err := gl.Publish(ctx, changes); nil != err {
+		t.Fatal(err)
+	}
+	changes[0].Title = "Renamed"
+	if err := gl.Publish(ctx, changes[:1]); nil != err {
+		t.Fatal(err)
+	}
+	got, err := gl.Releases(ctx)
+	if nil != err {
+		t.Fatal(err)
+	}
+	for i := range changes {
+		changes[i].Date = "2020-02-26T00:00:00Z"
+	}
+	if !reflect.DeepEqual(changes, got) {
+		t.Errorf("Releases() = %+v, want %+v", got, changes)
+	}
+}
@@ -0,0 +1,70 @@
+package version
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ChangesFromGitLog returns a draft Change describing the commits in the git
+// repository at directory repo that are reachable from tag toTag but not from
+// tag fromTag (all commits reachable from toTag if fromTag is empty). The
+// version is parsed from toTag (see ParseTolerant), the date is the commit date
+// of toTag, the description lists each commit subject from oldest to newest,
+// and the authors are the distinct commit authors.
+// Requires the git executable to be in PATH. Returns an error if either tag
+// begins with '-', which git would interpret as an option.
+func ChangesFromGitLog(repo, fromTag, toTag string) (Change, error) {
+	for _, tag := range []string{fromTag, toTag} {
+		if strings.HasPrefix(tag, "-") {
+			return Change{}, fmt.Errorf("invalid tag: %q", tag)
+		}
+	}
+	v, err := ParseTolerant(toTag)
+	if nil != err {
+		return Change{}, err
+	}
+	date, err := git(repo, "log", "-1", "--format=%cI", toTag)
+	if nil != err {
+		return Change{}, err
+	}
+	rev := toTag
+	if "" != fromTag {
+		rev = fromTag + ".." + toTag
+	}
+	out, err := git(repo, "log", "--reverse", "--no-merges", "--format=%an%x00%s", rev)
+	if nil != err {
+		return Change{}, err
+	}
+	c := Change{Version: v.String(), Date: date}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		if "" == line {
+			continue
+		}
+		author, subject := line, ""
+		if i := strings.IndexByte(line, 0); i >= 0 {
+			author, subject = line[:i], line[i+1:]
+		}
+		c.Description = append(c.Description, subject)
+		if !seen[author] {
+			seen[author] = true
+			c.Authors = append(c.Authors, author)
+		}
+	}
+	return c, nil
+}
+
+// git runs the git command with the given arguments in directory repo and
+// returns its standard output with surrounding whitespace removed.
+func git(repo string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); nil != err {
+		return "", fmt.Errorf("git %s: %v: %s", strings.Join(args, " "), err,
+			strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,59 @@
+package version_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestChangesFromGitLog(t *testing.T) {
+	if _, err := exec.LookPath("git"); nil != err {
+		t.Skip("git not found")
+	}
+	dir, err := ioutil.TempDir("", "gitlog")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	run := func(author string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME="+author, "GIT_AUTHOR_EMAIL=a@example.com",
+			"GIT_COMMITTER_NAME="+author, "GIT_COMMITTER_EMAIL=a@example.com",
+			"GIT_COMMITTER_DATE=2020-03-09T17:45:23Z")
+		if out, err := cmd.CombinedOutput(); nil != err {
+			t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+		}
+	}
+	run("Ann", "init", "-q")
+	run("Ann", "commit", "-q", "--allow-empty", "-m", "initial commit")
+	run("Ann", "tag", "v1.0.0")
+	run("Bob", "commit", "-q", "--allow-empty", "-m", "add feature")
+	run("Ann", "commit", "-q", "--allow-empty", "-m", "fix bug")
+	run("Ann", "tag", "v1.1.0")
+
+	c, err := version.ChangesFromGitLog(dir, "v1.0.0", "v1.1.0")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if "1.1.0" != c.Version || nil == version.ParseDate(c.Date) {
+		t.Errorf("version, date = %q, %q", c.Version, c.Date)
+	}
+	if got := strings.Join(c.Description, "; "); "add feature; fix bug" != got {
+		t.Errorf("description = %q", got)
+	}
+	if got := strings.Join(c.Authors, ", "); "Bob, Ann" != got {
+		t.Errorf("authors = %q", got)
+	}
+	if _, err := version.ChangesFromGitLog(dir, "v1.0.0", "v9.0.0"); nil == err {
+		t.Errorf("ChangesFromGitLog(unknown tag) = nil error")
+	}
+	if _, err := version.ChangesFromGitLog(dir, "--output=x", "v1.1.0"); nil == err {
+		t.Errorf("ChangesFromGitLog(option tag) = nil error")
+	}
+}
@@ -0,0 +1,62 @@
+//go:build hashicorp
+// +build hashicorp
+
+// Package hashiversion converts between the versions of the
+// github.com/ardnew/version package and github.com/hashicorp/go-version, so that
+// codebases migrating from one to the other can pass versions across libraries
+// without formatting and re-parsing strings.
+//
+// This package is only compiled with the "hashicorp" build tag, so that the
+// version package itself does not depend on go-version:
+//
+//	go build -tags hashicorp
+package hashiversion
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ardnew/version"
+	goversion "github.com/hashicorp/go-version"
+)
+
+// ToHashicorp returns the go-version equivalent of the given Semver.
+func ToHashicorp(v version.Semver) (*goversion.Version, error) {
+	return goversion.NewVersion(v.String())
+}
+
+// FromHashicorp returns the Semver equivalent of the given go-version. Omitted
+// minor and patch segments are zero. Returns an error if v has more than three
+// segments, or its prerelease or metadata is not valid in a semantic version
+// (go-version is more permissive than the Semantic Versioning specification).
+func FromHashicorp(v *goversion.Version) (version.Semver, error) {
+	if nil == v {
+		return version.Semver{}, errors.New("nil version")
+	}
+	seg := v.Segments64()
+	for len(seg) < 3 {
+		seg = append(seg, 0)
+	}
+	if len(seg) > 3 {
+		return version.Semver{}, fmt.Errorf("version %s has %d segments, want 3",
+			v.Original(), len(seg))
+	}
+	s := version.Semver{
+		Major:      uint(seg[0]),
+		Minor:      uint(seg[1]),
+		Patch:      uint(seg[2]),
+		Prerelease: v.Prerelease(),
+		Metadata:   v.Metadata(),
+	}
+	return version.ParseSemver(s.String())
+}
+
+// ToHashicorpString parses the given semantic version string, in any form
+// accepted by version.ParseTolerant, and returns its go-version equivalent.
+func ToHashicorpString(s string) (*goversion.Version, error) {
+	v, err := version.ParseTolerant(s)
+	if nil != err {
+		return nil, err
+	}
+	return ToHashicorp(v)
+}
@@ -0,0 +1,116 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// History is a changelog: a list of version changes, ordered from oldest to
+// newest. The package-level ChangeLog is the default History used by the
+// package-level functions; additional instances may be created to manage
+// several changelogs in one process.
+//
+// A History is a []Change, so existing slices may be converted or assigned to
+// one directly.
+type History []Change
+
+// Add validates Change c and appends it to History h. Returns an error if the
+// version string of c is invalid or an entry of the same Module with equal
+// precedence already exists in h.
+// If AutoDate is enabled and c has no Date, it is dated with the current time.
+func (h *History) Add(c Change) error {
+	if err := validate(c.Version); nil != err {
+		return err
+	}
+	if _, ok := h.ForModule(c.Module).Find(c.Version); ok {
+		return fmt.Errorf("duplicate version: %s", c.Version)
+	}
+	stamp(&c)
+	*h = append(*h, c)
+	if h == &ChangeLog {
+		notifyChangeAdded(c)
+	}
+	return nil
+}
+
+// Find returns the first entry in History h whose version has precedence equal
+// to the given version (i.e., ignoring build metadata). Returns false if there
+// is no such entry or the given version is invalid.
+// It panics if any of the version strings in h are invalid.
+func (h History) Find(version string) (Change, bool) {
+	if nil != validate(version) {
+		return Change{}, false
+	}
+	for _, c := range h {
+		if 0 == compareVersions(c.Version, version) {
+			return c, true
+		}
+	}
+	return Change{}, false
+}
+
+// ForModule returns the entries in History h whose Module is equal to the given
+// module, in order. Use an empty module to select the entries that are not
+// scoped to any module.
+func (h History) ForModule(module string) History {
+	var sub History
+	for _, c := range h {
+		if module == c.Module {
+			sub = append(sub, c)
+		}
+	}
+	return sub
+}
+
+// Modules returns the sorted, distinct names of each Module in History h,
+// excluding the empty name.
+func (h History) Modules() []string {
+	seen := map[string]bool{}
+	var list []string
+	for _, c := range h {
+		if "" != c.Module && !seen[c.Module] {
+			seen[c.Module] = true
+			list = append(list, c.Module)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Latest returns the entry in History h with the highest version precedence,
+// skipping entries marked Yanked. Returns false if there is no such entry.
+// It panics if any of the version strings are invalid.
+func (h History) Latest() (Change, bool) {
+	return h.latest(false)
+}
+
+// LatestStable returns the entry in History h with the highest version
+// precedence, skipping entries marked Yanked and prerelease versions. Returns
+// false if there is no such entry.
+// It panics if any of the version strings are invalid.
+func (h History) LatestStable() (Change, bool) {
+	return h.latest(true)
+}
+
+func (h History) latest(stable bool) (Change, bool) {
+	best := -1
+	for i, c := range h {
+		if c.Yanked || (stable && isPrerelease(c.Version)) {
+			continue
+		}
+		if best < 0 || compareVersions(c.Version, h[best].Version) > 0 {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Change{}, false
+	}
+	return h[best], true
+}
+
+// Render writes to w each of the entries in History h, as written by
+// FprintChangeLog. Returns the first error encountered.
+func (h History) Render(w io.Writer) error {
+	return globalConfig().FprintChangeLog(w, h)
+}
@@ -0,0 +1,64 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestHistory(t *testing.T) {
+	var h version.History
+	for _, v := range []string{"0.1.0", "0.2.0-rc.1", "0.2.0"} {
+		if err := h.Add(version.Change{Version: v}); nil != err {
+			t.Fatalf("Add(%s) = %v", v, err)
+		}
+	}
+	if err := h.Add(version.Change{Version: "0.2.0+build.1"}); nil == err {
+		t.Errorf("Add(duplicate) = nil error")
+	}
+	if err := h.Add(version.Change{Version: "0.3"}); nil == err {
+		t.Errorf("Add(invalid) = nil error")
+	}
+	if c, ok := h.Find("0.2.0-rc.1"); !ok || "0.2.0-rc.1" != c.Version {
+		t.Errorf("Find(0.2.0-rc.1) = %s, %t", c.Version, ok)
+	}
+	if _, ok := h.Find("0.4.0"); ok {
+		t.Errorf("Find(0.4.0) = true")
+	}
+	if c, ok := h.Latest(); !ok || "0.2.0" != c.Version {
+		t.Errorf("Latest() = %s, %t; want 0.2.0", c.Version, ok)
+	}
+	var b strings.Builder
+	if err := h.Render(&b); nil != err || 3 != strings.Count(b.String(), "version 0.") {
+		t.Errorf("Render() = %v; wrote %q", err, b.String())
+	}
+}
+
+func TestHistoryModules(t *testing.T) {
+	h := version.History{
+		{Module: "cli", Version: "1.0.0"},
+		{Module: "lib", Version: "1.0.0"},
+		{Version: "0.9.0"},
+		{Module: "lib", Version: "1.2.0"},
+		{Module: "cli", Version: "1.1.0"},
+	}
+	if got := strings.Join(h.Modules(), ","); "cli,lib" != got {
+		t.Errorf("Modules() = %q, want %q", got, "cli,lib")
+	}
+	if c, ok := h.ForModule("lib").Latest(); !ok || "1.2.0" != c.Version {
+		t.Errorf("ForModule(lib).Latest() = %s, %t; want 1.2.0", c.Version, ok)
+	}
+	if n := len(h.ForModule("")); 1 != n {
+		t.Errorf("len(ForModule(\"\")) = %d, want 1", n)
+	}
+	if err := h.Add(version.Change{Module: "cli", Version: "1.2.0"}); nil != err {
+		t.Errorf("Add(cli 1.2.0) = %v", err)
+	}
+	if err := h.Add(version.Change{Module: "cli", Version: "1.2.0"}); nil == err {
+		t.Errorf("Add(duplicate cli 1.2.0) = nil error")
+	}
+	if !strings.Contains(h[0].Layout(version.RenderOptions{Width: 40}), "cli version 1.0.0") {
+		t.Errorf("Layout() does not include module")
+	}
+}
@@ -0,0 +1,86 @@
+package version
+
+import "sync"
+
+// hooks contains the callbacks registered with OnVersionChange and
+// OnChangeAdded, identified by their address so that they may be removed.
+// The lists are never modified in place, so that a copy of either may be
+// iterated without holding the lock.
+var hooks = struct {
+	sync.RWMutex
+	version []*func(old, new Semver)
+	change  []*func(Change)
+}{}
+
+// OnVersionChange registers fn to be called whenever the package version is
+// changed by Set (including indirectly, by AddChange or FromFile), with the
+// previous and new values of Version. Callbacks are called synchronously, in
+// the order registered, and are not called if the version is unchanged or
+// Version is assigned directly. The returned function removes the callback;
+// subsequent calls to it have no effect.
+// It is safe to call OnVersionChange from multiple goroutines.
+func OnVersionChange(fn func(old, new Semver)) (remove func()) {
+	h := &fn
+	hooks.Lock()
+	defer hooks.Unlock()
+	hooks.version = append(hooks.version[:len(hooks.version):len(hooks.version)], h)
+	return func() {
+		hooks.Lock()
+		defer hooks.Unlock()
+		var list []*func(old, new Semver)
+		for _, e := range hooks.version {
+			if e != h {
+				list = append(list, e)
+			}
+		}
+		hooks.version = list
+	}
+}
+
+// OnChangeAdded registers fn to be called with each Change appended to
+// ChangeLog by AddChange or ChangeLog.Add, after it has been appended.
+// Callbacks are called synchronously, in the order registered, and are not
+// called if ChangeLog is assigned directly. The returned function removes the
+// callback; subsequent calls to it have no effect.
+// It is safe to call OnChangeAdded from multiple goroutines.
+func OnChangeAdded(fn func(Change)) (remove func()) {
+	h := &fn
+	hooks.Lock()
+	defer hooks.Unlock()
+	hooks.change = append(hooks.change[:len(hooks.change):len(hooks.change)], h)
+	return func() {
+		hooks.Lock()
+		defer hooks.Unlock()
+		var list []*func(Change)
+		for _, e := range hooks.change {
+			if e != h {
+				list = append(list, e)
+			}
+		}
+		hooks.change = list
+	}
+}
+
+// notifyVersionChange calls each OnVersionChange callback if old and new
+// differ.
+func notifyVersionChange(old, new Semver) {
+	if old == new {
+		return
+	}
+	hooks.RLock()
+	list := hooks.version
+	hooks.RUnlock()
+	for _, fn := range list {
+		(*fn)(old, new)
+	}
+}
+
+// notifyChangeAdded calls each OnChangeAdded callback with Change c.
+func notifyChangeAdded(c Change) {
+	hooks.RLock()
+	list := hooks.change
+	hooks.RUnlock()
+	for _, fn := range list {
+		(*fn)(c)
+	}
+}
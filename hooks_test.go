@@ -0,0 +1,55 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestHooks(t *testing.T) {
+	defer func(v version.Semver, log version.History) {
+		version.Version, version.ChangeLog = v, log
+	}(version.Version, version.ChangeLog)
+
+	var events []string
+	removeVersion := version.OnVersionChange(func(old, new version.Semver) {
+		events = append(events, fmt.Sprintf("version %s -> %s", old, new))
+	})
+	defer removeVersion()
+	removeChange := version.OnChangeAdded(func(c version.Change) {
+		events = append(events, "added "+c.Version)
+	})
+	defer removeChange()
+
+	version.Version, version.ChangeLog = version.Semver{}, nil
+	version.Set("1.0.0")
+	version.Set("1.0.0") // unchanged
+	if err := version.AddChange(version.Change{Version: "1.1.0"}); nil != err {
+		t.Fatal(err)
+	}
+	if err := version.ChangeLog.Add(version.Change{Version: "1.2.0"}); nil != err {
+		t.Fatal(err)
+	}
+	var other version.History
+	other.Add(version.Change{Version: "9.0.0"}) // not ChangeLog
+
+	want := []string{
+		"version 0.0.0 -> 1.0.0",
+		"added 1.1.0",
+		"version 1.0.0 -> 1.1.0",
+		"added 1.2.0",
+	}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+
+	removeVersion()
+	removeChange()
+	events = nil
+	version.Set("3.0.0")
+	version.ChangeLog.Add(version.Change{Version: "3.1.0"})
+	if 0 != len(events) {
+		t.Errorf("events after removal = %q, want none", events)
+	}
+}
@@ -0,0 +1,184 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Info bundles the version, changelog, and settings of a program or library,
+// so that a library may carry its own version information without modifying
+// the package-level Version and ChangeLog of the host application. Construct
+// one with New.
+//
+// The package-level functions, such as Set, String, AddChange, and
+// FprintPackageVersion, delegate to Default.
+type Info struct {
+	Name string // package name written by FprintPackageVersion, if non-empty
+
+	version   *Semver
+	changelog *History
+	config    func() *Config
+}
+
+// Default is the Info of the package-level Version and ChangeLog, using the
+// package-level settings (see VersionPattern, VersionScheme, DateTimeFormat,
+// etc.). Changes made through Default are observed by the callbacks registered
+// with OnVersionChange and OnChangeAdded.
+var Default = &Info{version: &Version, changelog: &ChangeLog, config: globalConfig}
+
+// New returns an Info with the given package name, an unset version, and an
+// empty changelog, using the settings of NewConfig(opts...).
+func New(name string, opts ...Option) *Info {
+	cfg := NewConfig(opts...)
+	return &Info{
+		Name:      name,
+		version:   &Semver{},
+		changelog: &History{},
+		config:    func() *Config { return cfg },
+	}
+}
+
+// Version returns the version of Info i, as set by Set.
+func (i *Info) Version() Semver {
+	return *i.version
+}
+
+// ChangeLog returns the changelog of Info i, which may be modified in place.
+func (i *Info) ChangeLog() *History {
+	return i.changelog
+}
+
+// Config returns the settings of Info i.
+func (i *Info) Config() *Config {
+	return i.config()
+}
+
+// Set sets the version of Info i using the given version string.
+// Returns an error, without modifying the version, if it is invalid.
+func (i *Info) Set(version string) error {
+	v, err := i.config().Parse(version)
+	if nil != err {
+		return err
+	}
+	old := *i.version
+	*i.version = v
+	if i == Default {
+		notifyVersionChange(old, v)
+	}
+	return nil
+}
+
+// IsSet returns true if and only if the version of Info i has been set, as
+// described by the package-level IsSet.
+func (i *Info) IsSet() bool {
+	return Semver{} != *i.version
+}
+
+// latest returns the last entry in the changelog of Info i without a Module,
+// which describes the package itself, or nil if there is no such entry.
+// Entries of other modules do not version the package.
+func (i *Info) latest() *Change {
+	log := *i.changelog
+	for n := len(log) - 1; n >= 0; n-- {
+		if "" == log[n].Module {
+			return &log[n]
+		}
+	}
+	return nil
+}
+
+// VersionString returns the version string of Info i, as described by the
+// package-level String, or an error if the last entry in its changelog without
+// a Module contains an invalid version string.
+func (i *Info) VersionString() (string, error) {
+	if i.IsSet() {
+		return i.version.String(), nil
+	}
+	c := i.latest()
+	if nil == c {
+		return "", nil
+	}
+	cfg := i.config()
+	ver := c.Version
+	if err := cfg.Validate(ver); nil != err {
+		return "", err
+	}
+	if nil != cfg.Scheme || "" != cfg.CalVerFormat {
+		return ver, nil
+	}
+	v, err := cfg.Parse(ver)
+	return v.String(), err
+}
+
+// AddChange validates Change c and appends it to the changelog of Info i,
+// updating the version of i, as described by the package-level AddChange.
+func (i *Info) AddChange(c Change) error {
+	cfg := i.config()
+	if err := cfg.Validate(c.Version); nil != err {
+		return err
+	}
+	latest := ""
+	if "" == c.Module {
+		cur, err := i.VersionString()
+		if nil != err {
+			return err
+		}
+		latest = cur
+	}
+	for _, e := range i.changelog.ForModule(c.Module) {
+		if err := cfg.Validate(e.Version); nil != err {
+			return fmt.Errorf("changelog: %v", err)
+		}
+		if "" == latest || cfg.compare(e.Version, latest) > 0 {
+			latest = e.Version
+		}
+	}
+	if "" != latest && cfg.compare(c.Version, latest) <= 0 {
+		return fmt.Errorf("version %s is not greater than latest version %s",
+			c.Version, latest)
+	}
+	stamp(&c)
+	*i.changelog = append(*i.changelog, c)
+	if i == Default {
+		notifyChangeAdded(c)
+	}
+	if "" == c.Module && i.IsSet() && nil == cfg.Scheme && "" == cfg.CalVerFormat {
+		i.Set(c.Version)
+	}
+	return nil
+}
+
+// FprintPackageVersion writes to given io.Writer w a descriptive version string
+// of Info i, as described by the package-level FprintPackageVersion. The package
+// name is i.Name if defined, or else that of the last changelog entry without a
+// Module.
+func (i *Info) FprintPackageVersion(w io.Writer) error {
+	b := strings.Builder{}
+	name := i.Name
+	if c := i.latest(); "" == name && nil != c {
+		name = c.Package
+	}
+	b.WriteString(name)
+	ver, err := i.VersionString()
+	if nil != err {
+		return err
+	}
+	if "" != ver {
+		if b.Len() > 0 {
+			b.WriteRune(' ')
+		}
+		b.WriteString("version ")
+		b.WriteString(ver)
+	}
+	if b.Len() > 0 {
+		_, err = fmt.Fprintf(w, "%s\n", b.String())
+	}
+	return err
+}
+
+// FprintChangeLog writes to given io.Writer w all of the entries in the
+// changelog of Info i, as described by the package-level FprintChangeLog.
+func (i *Info) FprintChangeLog(w io.Writer) error {
+	return i.config().FprintChangeLog(w, *i.changelog)
+}
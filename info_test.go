@@ -0,0 +1,74 @@
+package version_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleNew() {
+	lib := version.New("mylib")
+	lib.AddChange(version.Change{Version: "1.0.0", Title: "First"})
+	lib.AddChange(version.Change{Version: "1.1.0", Title: "Second"})
+	lib.FprintPackageVersion(os.Stdout)
+	// Output:
+	// mylib version 1.1.0
+}
+
+func TestInfo(t *testing.T) {
+	defer func(v version.Semver, log version.History) {
+		version.Version, version.ChangeLog = v, log
+	}(version.Version, version.ChangeLog)
+	version.Set("2.0.0")
+
+	lib := version.New("lib")
+	if lib.IsSet() {
+		t.Error("New(): version is set")
+	}
+	if err := lib.Set("1.2"); nil == err {
+		t.Error("Set(1.2): expected error")
+	}
+	if err := lib.Set("1.2.3"); nil != err {
+		t.Fatal(err)
+	}
+	if err := lib.AddChange(version.Change{Version: "1.2.0"}); nil == err {
+		t.Error("AddChange(1.2.0): expected error for version below 1.2.3")
+	}
+	if err := lib.AddChange(version.Change{Version: "1.3.0"}); nil != err {
+		t.Fatal(err)
+	}
+	if got := lib.Version().String(); "1.3.0" != got {
+		t.Errorf("Version() = %s, want 1.3.0", got)
+	}
+	if n := len(*lib.ChangeLog()); 1 != n {
+		t.Errorf("len(ChangeLog()) = %d, want 1", n)
+	}
+
+	// the package-level version is unaffected, and delegates to Default
+	if got := version.String(); "2.0.0" != got {
+		t.Errorf("String() = %s, want 2.0.0", got)
+	}
+	if got, _ := version.Default.VersionString(); "2.0.0" != got {
+		t.Errorf("Default.VersionString() = %s, want 2.0.0", got)
+	}
+	// the package is described by its last entry without a Module
+	app := version.New("")
+	*app.ChangeLog() = version.History{
+		{Package: "app", Version: "1.0.0"},
+		{Package: "sub", Module: "x/sub", Version: "0.3.0"},
+	}
+	var b strings.Builder
+	if err := app.FprintPackageVersion(&b); nil != err || "app version 1.0.0\n" != b.String() {
+		t.Errorf("FprintPackageVersion = %q, %v, want app version 1.0.0", b.String(), err)
+	}
+	version.Version, version.ChangeLog = version.Semver{}, *app.ChangeLog()
+	if info, err := version.CurrentWindowsVersionInfo(); nil != err || "app" != info.StringFileInfo.ProductName {
+		t.Errorf("CurrentWindowsVersionInfo() ProductName = %q, %v, want app",
+			info.StringFileInfo.ProductName, err)
+	}
+	if d, err := version.PackageDetails(); nil != err || "app" != d.Package {
+		t.Errorf("PackageDetails() Package = %q, %v, want app", d.Package, err)
+	}
+}
@@ -0,0 +1,70 @@
+package version
+
+import "time"
+
+// Labels defines the literal strings written by Layout, so that non-English
+// products can ship translated output. Assign a modified copy of DefaultLabels
+// to RenderOptions.Labels:
+//
+//	labels := version.DefaultLabels
+//	labels.Version, labels.Authors = "Version", "Autoren"
+//	opts := version.DefaultRenderOptions
+//	opts.Labels = &labels
+type Labels struct {
+	Version     string // precedes the version string in the header
+	Breaking    string // badge of a Change with Breaking set
+	Yanked      string // badge of a Change with Yanked set
+	Unsupported string // badge of a version older than MinSupported
+	Features    string // heading of the features available since a version
+	Authors     string // heading of the authors of a Change
+	Deprecated  string // heading of the deprecations of a Change
+	Artifacts   string // heading of the artifacts of a Change
+
+	// FormatDate, if non-nil, returns date-time t formatted with the given
+	// layout (DateTimeFormat), e.g., with localized month and weekday names.
+	// Otherwise, t.Format is used.
+	FormatDate func(t time.Time, layout string) string
+
+	// RelativeTime, if non-nil, returns a description of date-time t relative to
+	// the current time now, used for DateRelative and DateBoth. Otherwise, the
+	// package-level RelativeTime is used.
+	RelativeTime func(t, now time.Time) string
+}
+
+// DefaultLabels defines the English labels used if RenderOptions.Labels is nil.
+var DefaultLabels = Labels{
+	Version:     "version",
+	Breaking:    "BREAKING",
+	Yanked:      "YANKED",
+	Unsupported: "UNSUPPORTED",
+	Features:    "Features",
+	Authors:     "Authors",
+	Deprecated:  "Deprecated",
+	Artifacts:   "Artifacts",
+}
+
+// labels returns opts.Labels, or DefaultLabels if it is nil.
+func (opts RenderOptions) labels() *Labels {
+	if nil != opts.Labels {
+		return opts.Labels
+	}
+	return &DefaultLabels
+}
+
+// formatDate returns date-time t formatted with the given layout, as described
+// by FormatDate.
+func (l *Labels) formatDate(t time.Time, layout string) string {
+	if nil != l.FormatDate {
+		return l.FormatDate(t, layout)
+	}
+	return t.Format(layout)
+}
+
+// relativeTime returns date-time t relative to now, as described by
+// RelativeTime.
+func (l *Labels) relativeTime(t, now time.Time) string {
+	if nil != l.RelativeTime {
+		return l.RelativeTime(t, now)
+	}
+	return RelativeTime(t, now)
+}
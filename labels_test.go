@@ -0,0 +1,31 @@
+package version_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleLabels() {
+	months := []string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli",
+		"August", "September", "Oktober", "November", "Dezember"}
+	labels := version.DefaultLabels
+	labels.Version, labels.Yanked, labels.Authors = "Version", "ZURÜCKGEZOGEN", "Autoren"
+	labels.FormatDate = func(t time.Time, _ string) string {
+		return fmt.Sprintf("%d. %s %d", t.Day(), months[t.Month()-1], t.Year())
+	}
+	opts := version.RenderOptions{Width: 50, Rule: '=', Margin: 1, Indent: 2, Labels: &labels}
+	c := version.Change{
+		Version: "1.2.3",
+		Date:    "2020-03-09",
+		Yanked:  true,
+		Authors: []string{"Ada"},
+	}
+	fmt.Print(c.Layout(opts))
+	// Output:
+	// ==================================================
+	//  Version 1.2.3 [ZURÜCKGEZOGEN]       9. März 2020
+	// ==================================================
+	//   Autoren: Ada
+}
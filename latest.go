@@ -0,0 +1,60 @@
+package version
+
+// Latest returns the entry in ChangeLog with the highest version precedence,
+// skipping entries marked Yanked. Unlike String, which uses the last entry in
+// ChangeLog, the order of entries is insignificant. Returns false if there is
+// no such entry.
+// It panics if any of the version strings are invalid.
+func Latest() (Change, bool) {
+	return ChangeLog.Latest()
+}
+
+// LatestStable returns the entry in ChangeLog with the highest version
+// precedence, skipping entries marked Yanked and prerelease versions. Returns
+// false if there is no such entry.
+// It panics if any of the version strings are invalid.
+func LatestStable() (Change, bool) {
+	return ChangeLog.LatestStable()
+}
+
+// LatestModule returns the entry in ChangeLog for the given Module with the
+// highest version precedence, skipping entries marked Yanked. Returns false if
+// there is no such entry.
+// It panics if any of the version strings are invalid.
+func LatestModule(module string) (Change, bool) {
+	return ChangeLog.ForModule(module).Latest()
+}
+
+// LatestVersion returns the semantic version string in the given list with the
+// highest precedence. Invalid version strings are ignored. Returns false if
+// the list contains no valid version.
+func LatestVersion(versions []string) (string, bool) {
+	return latestVersion(versions, false)
+}
+
+// LatestStableVersion returns the semantic version string in the given list
+// with the highest precedence, ignoring prerelease versions and invalid version
+// strings. Returns false if the list contains no such version.
+func LatestStableVersion(versions []string) (string, bool) {
+	return latestVersion(versions, true)
+}
+
+func latestVersion(versions []string, stable bool) (string, bool) {
+	best := ""
+	for _, v := range versions {
+		if !IsValid(v) || (stable && isPrerelease(v)) {
+			continue
+		}
+		if "" == best || Compare(v, best) > 0 {
+			best = v
+		}
+	}
+	return best, "" != best
+}
+
+// isPrerelease returns true if and only if the given version string identifies
+// a prerelease according to the versioning scheme in use (see VersionScheme).
+// Calendar versions (see CalVerFormat) are never considered prereleases.
+func isPrerelease(version string) bool {
+	return activeScheme().IsPrerelease(version)
+}
@@ -0,0 +1,40 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestLatest(t *testing.T) {
+	defer func(log []version.Change) { version.ChangeLog = log }(version.ChangeLog)
+	version.ChangeLog = []version.Change{
+		{Version: "1.1.0"},
+		{Version: "2.0.0", Yanked: true},
+		{Version: "1.3.0-rc.1"},
+		{Version: "1.2.0"},
+	}
+	if c, ok := version.Latest(); !ok || "1.3.0-rc.1" != c.Version {
+		t.Errorf("Latest() = %s, %t; want 1.3.0-rc.1", c.Version, ok)
+	}
+	if c, ok := version.LatestStable(); !ok || "1.2.0" != c.Version {
+		t.Errorf("LatestStable() = %s, %t; want 1.2.0", c.Version, ok)
+	}
+	version.ChangeLog = nil
+	if _, ok := version.Latest(); ok {
+		t.Errorf("Latest() of empty ChangeLog = true")
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	list := []string{"1.0.0", "bogus", "1.10.0-beta", "1.9.2", "1.2.0"}
+	if v, ok := version.LatestVersion(list); !ok || "1.10.0-beta" != v {
+		t.Errorf("LatestVersion() = %q, %t; want 1.10.0-beta", v, ok)
+	}
+	if v, ok := version.LatestStableVersion(list); !ok || "1.9.2" != v {
+		t.Errorf("LatestStableVersion() = %q, %t; want 1.9.2", v, ok)
+	}
+	if _, ok := version.LatestStableVersion([]string{"1.0.0-rc.1"}); ok {
+		t.Errorf("LatestStableVersion(prerelease only) = true")
+	}
+}
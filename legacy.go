@@ -0,0 +1,163 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LegacyVersion is a four-component version of the form MAJOR.MINOR.PATCH.BUILD,
+// as used by Windows products and some firmware. It converts losslessly to a
+// Semver whose build metadata is the BUILD component (e.g., "1.2.3.4" and
+// "1.2.3+4"); see LegacyScheme and the Mode Legacy to use it in place of
+// semantic versions.
+type LegacyVersion struct {
+	Major uint
+	Minor uint
+	Patch uint
+	Build uint
+}
+
+// ParseLegacy parses a four-component version string, such as "10.0.19041.1".
+// Each component is a decimal number without leading zeros.
+// Returns an error if the version string is invalid.
+func ParseLegacy(version string) (LegacyVersion, error) {
+	part := strings.Split(version, ".")
+	if 4 != len(part) {
+		return LegacyVersion{}, fmt.Errorf("invalid version: %s: expected 4 components", version)
+	}
+	var n [4]uint
+	for i, s := range part {
+		u, err := parseNumber(s)
+		if nil != err {
+			return LegacyVersion{}, fmt.Errorf("invalid version: %s: %v", version, err)
+		}
+		n[i] = u
+	}
+	return LegacyVersion{Major: n[0], Minor: n[1], Patch: n[2], Build: n[3]}, nil
+}
+
+// parseNumber parses a numeric version component without leading zeros.
+func parseNumber(s string) (uint, error) {
+	if len(s) > 1 && '0' == s[0] {
+		return 0, fmt.Errorf("leading zero in %q", s)
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid component %q", s)
+		}
+	}
+	u, err := strconv.ParseUint(s, 10, 0)
+	if nil != err {
+		return 0, fmt.Errorf("invalid component %q", s)
+	}
+	return uint(u), nil
+}
+
+// String returns the four-component version string of v.
+func (v LegacyVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Patch, v.Build)
+}
+
+// Compare returns an integer comparing versions v and o component-wise: -1 if
+// v < o, 0 if v == o, and +1 if v > o.
+func (v LegacyVersion) Compare(o LegacyVersion) int {
+	for _, c := range [...]int{
+		compareUint(v.Major, o.Major), compareUint(v.Minor, o.Minor),
+		compareUint(v.Patch, o.Patch), compareUint(v.Build, o.Build),
+	} {
+		if 0 != c {
+			return c
+		}
+	}
+	return 0
+}
+
+// Semver returns the semantic version equivalent to v, with the BUILD component
+// as its build metadata (e.g., "1.2.3+4"). Note that build metadata does not
+// affect semantic version precedence; use CompareWithMetadata to order
+// the results like LegacyVersion.Compare.
+func (v LegacyVersion) Semver() Semver {
+	return Semver{
+		Major: v.Major, Minor: v.Minor, Patch: v.Patch,
+		Metadata: strconv.FormatUint(uint64(v.Build), 10),
+	}
+}
+
+// LegacyFromSemver returns the four-component version equivalent to semantic
+// version s, as converted by LegacyVersion.Semver. Missing build metadata is
+// interpreted as BUILD 0.
+// Returns an error if s has a prerelease, or build metadata that is not a
+// single number, neither of which can be represented.
+func LegacyFromSemver(s Semver) (LegacyVersion, error) {
+	if "" != s.Prerelease {
+		return LegacyVersion{}, fmt.Errorf("cannot convert %s: prerelease", s)
+	}
+	v := LegacyVersion{Major: s.Major, Minor: s.Minor, Patch: s.Patch}
+	if "" != s.Metadata {
+		b, err := parseNumber(s.Metadata)
+		if nil != err {
+			return LegacyVersion{}, fmt.Errorf("cannot convert %s: metadata: %v", s, err)
+		}
+		v.Build = b
+	}
+	return v, nil
+}
+
+// Legacy accepts four-component versions (see ParseLegacy), converted to
+// semantic versions by LegacyVersion.Semver.
+var Legacy Mode = func(version string) (Semver, error) {
+	v, err := ParseLegacy(version)
+	return v.Semver(), err
+}
+
+// LegacyScheme is the Scheme of four-component versions (see LegacyVersion).
+// Bump increments the "major", "minor", "patch", or (by default) "build"
+// component, resetting those that follow it.
+var LegacyScheme Scheme = legacyScheme{}
+
+type legacyScheme struct{}
+
+func (legacyScheme) Validate(version string) error {
+	_, err := ParseLegacy(version)
+	return err
+}
+
+func (legacyScheme) Compare(a, b string) int {
+	av, err := ParseLegacy(a)
+	if nil != err {
+		panic(err.Error())
+	}
+	bv, err := ParseLegacy(b)
+	if nil != err {
+		panic(err.Error())
+	}
+	return av.Compare(bv)
+}
+
+func (legacyScheme) IsPrerelease(string) bool {
+	return false
+}
+
+func (legacyScheme) Bump(version, part string) (string, error) {
+	var v LegacyVersion
+	if "" != version {
+		var err error
+		if v, err = ParseLegacy(version); nil != err {
+			return "", err
+		}
+	}
+	switch strings.ToLower(part) {
+	case "major":
+		v = LegacyVersion{Major: v.Major + 1}
+	case "minor":
+		v = LegacyVersion{Major: v.Major, Minor: v.Minor + 1}
+	case "patch":
+		v = LegacyVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	case "build", "":
+		v.Build++
+	default:
+		return "", fmt.Errorf("bump: expected one of: major, minor, patch, build (not %q)", part)
+	}
+	return v.String(), nil
+}
@@ -0,0 +1,63 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleLegacyVersion() {
+	v, err := version.ParseLegacy("10.0.19041.1")
+	if nil != err {
+		panic(err)
+	}
+	s := v.Semver()
+	back, _ := version.LegacyFromSemver(s)
+	fmt.Println(v, s, back)
+	// Output:
+	// 10.0.19041.1 10.0.19041+1 10.0.19041.1
+}
+
+func ExampleLegacyScheme() {
+	defer func(s version.Scheme, v version.Semver, log version.History) {
+		version.VersionScheme, version.Version, version.ChangeLog = s, v, log
+	}(version.VersionScheme, version.Version, version.ChangeLog)
+
+	version.VersionScheme = version.LegacyScheme
+	version.Version, version.ChangeLog = version.Semver{}, nil
+	version.AddChange(version.Change{Version: "1.2.3.9"})
+	version.AddChange(version.Change{Version: "1.2.3.10"})
+	next, _ := version.Bump(version.String(), "build")
+	fmt.Println(version.String(), next)
+	// Output:
+	// 1.2.3.10 1.2.3.11
+}
+
+func TestLegacy(t *testing.T) {
+	for _, s := range []string{"1.2.3", "1.2.3.4.5", "1.2.3.04", "1.2.3.x", "1.2.3.", "-1.2.3.4"} {
+		if _, err := version.ParseLegacy(s); nil == err {
+			t.Errorf("ParseLegacy(%q): expected error", s)
+		}
+	}
+	for _, s := range []string{"1.2.3-rc.1", "1.2.3+build.4"} {
+		if _, err := version.LegacyFromSemver(version.MustParse(s)); nil == err {
+			t.Errorf("LegacyFromSemver(%s): expected error", s)
+		}
+	}
+	if v, err := version.ParseMode("1.2.3.4", version.Legacy); nil != err || "1.2.3+4" != v.String() {
+		t.Errorf("ParseMode(Legacy) = %s, %v", v, err)
+	}
+	a, _ := version.ParseLegacy("1.2.3.10")
+	b, _ := version.ParseLegacy("1.2.3.9")
+	if 1 != a.Compare(b) || 1 != version.CompareWithMetadata(a.Semver().String(), b.Semver().String()) {
+		t.Errorf("%s does not follow %s", a, b)
+	}
+	for part, want := range map[string]string{
+		"major": "2.0.0.0", "minor": "1.3.0.0", "patch": "1.2.4.0", "build": "1.2.3.5",
+	} {
+		if got, err := version.LegacyScheme.Bump("1.2.3.4", part); nil != err || got != want {
+			t.Errorf("Bump(1.2.3.4, %s) = %s, %v; want %s", part, got, err, want)
+		}
+	}
+}
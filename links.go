@@ -0,0 +1,66 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// IssueURLFormat defines the URL of an issue or pull request, given its number
+// as the only argument of a fmt verb such as %d; for example:
+//
+//	https://github.com/owner/repo/issues/%d
+//
+// Issue references such as "#123" or "GH-123" found in the description or Links
+// of a Change are listed with their URLs when rendered. If empty, only the
+// Links that are URLs are listed.
+var IssueURLFormat string
+
+// issuePattern matches issue references of the form "#123" or "GH-123".
+var issuePattern = regexp.MustCompile(`(?:^|[^\w&/])((?:#|GH-)(\d+))\b`)
+
+// Reference identifies a resource related to a Change, such as an issue, pull
+// request, or discussion.
+type Reference struct {
+	Label string // issue reference (e.g., "#123"), or empty for plain URLs
+	URL   string // URL of the resource, or empty if unknown
+}
+
+// References returns the issue references found in the description and Links of
+// Change c, followed by the remaining Links (assumed to be URLs), without
+// duplicates. Issue URLs are constructed with IssueURLFormat.
+func (c *Change) References() []Reference {
+	var refs []Reference
+	seen := map[string]bool{}
+	issue := func(label, num string) {
+		if seen[label] {
+			return
+		}
+		seen[label] = true
+		ref := Reference{Label: label}
+		if n, err := strconv.Atoi(num); nil == err && "" != IssueURLFormat {
+			ref.URL = fmt.Sprintf(IssueURLFormat, n)
+		}
+		refs = append(refs, ref)
+	}
+	for _, line := range c.Description {
+		for _, m := range issuePattern.FindAllStringSubmatch(line, -1) {
+			issue(m[1], m[2])
+		}
+	}
+	var urls []string
+	for _, link := range c.Links {
+		if m := issuePattern.FindStringSubmatch(link); nil != m && m[1] == link {
+			issue(m[1], m[2])
+		} else {
+			urls = append(urls, link)
+		}
+	}
+	for _, u := range urls {
+		if !seen[u] {
+			seen[u] = true
+			refs = append(refs, Reference{URL: u})
+		}
+	}
+	return refs
+}
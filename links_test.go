@@ -0,0 +1,34 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleChange_References() {
+	format := version.IssueURLFormat
+	defer func() { version.IssueURLFormat = format }()
+	version.IssueURLFormat = "https://github.com/ardnew/version/issues/%d"
+
+	c := version.Change{
+		Version:     "0.2.0",
+		Description: []string{"add feature: Dude (#12)", "fix bug GH-15, not &#38;"},
+		Links:       []string{"#12", "https://example.com/discussion"},
+	}
+	opts := version.DefaultRenderOptions
+	opts.Width = 50
+	opts.Rule = '-'
+	fmt.Print(c.Layout(opts))
+
+	// Output:
+	// --------------------------------------------------
+	//  version 0.2.0
+	// --------------------------------------------------
+	//   add feature: Dude (#12)
+	//   fix bug GH-15, not &#38;
+	//
+	//   [#12] https://github.com/ardnew/version/issues/12
+	//   [GH-15] https://github.com/ardnew/version/issues/15
+	//   [1] https://example.com/discussion
+}
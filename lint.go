@@ -0,0 +1,149 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LintRules configures the house rules enforced by Lint.
+type LintRules struct {
+	MaxTitleLength     int      // maximum runes in a title, or 0 for no limit
+	Imperative         bool     // description lines begin with an imperative verb ("Add", not "Added")
+	Categories         []string // if non-empty, description lines are prefixed by one of these categories ("Fixed: ")
+	RequireDates       bool     // released (non-prerelease) versions have a date
+	RequireDescription bool     // every entry has at least one non-blank description line
+}
+
+// DefaultLintRules defines the rules used by the "lint" command of cmd/version.
+var DefaultLintRules = LintRules{
+	MaxTitleLength:     72,
+	Imperative:         true,
+	RequireDates:       true,
+	RequireDescription: true,
+}
+
+// LintFinding describes a violation of a rule found by Lint.
+type LintFinding struct {
+	Index   int    // index of the entry in the changelog
+	Version string // version of the entry
+	Line    int    // index of the description line, or -1 if not specific to a line
+	Rule    string // "version", "title", "imperative", "category", "date", or "description"
+	Message string
+}
+
+// String returns a description of LintFinding f, such as:
+//
+//	entry 3 (1.2.0) line 1: [imperative] "Fixed" is not in the imperative mood
+func (f LintFinding) String() string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "entry %d (%s)", f.Index, f.Version)
+	if f.Line >= 0 {
+		fmt.Fprintf(&b, " line %d", f.Line)
+	}
+	fmt.Fprintf(&b, ": [%s] %s", f.Rule, f.Message)
+	return b.String()
+}
+
+// Lint checks every entry in ChangeLog against the given rules, and returns a
+// finding for each violation, ordered by entry and line.
+func Lint(rules LintRules) []LintFinding {
+	return ChangeLog.Lint(rules)
+}
+
+// Lint checks every entry in History h against the given rules, as described
+// by the package-level Lint.
+func (h History) Lint(rules LintRules) []LintFinding {
+	var list []LintFinding
+	for i, c := range h {
+		report := func(line int, rule, format string, args ...interface{}) {
+			list = append(list, LintFinding{
+				Index: i, Version: c.Version, Line: line, Rule: rule,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+		if err := validate(c.Version); nil != err {
+			report(-1, "version", "%v", err)
+		}
+		if n := utf8.RuneCountInString(c.Title); rules.MaxTitleLength > 0 && n > rules.MaxTitleLength {
+			report(-1, "title", "title has %d characters, exceeding %d", n, rules.MaxTitleLength)
+		}
+		if rules.RequireDates {
+			if "" == c.Date {
+				if nil == validate(c.Version) && !isPrerelease(c.Version) {
+					report(-1, "date", "released version has no date")
+				}
+			} else if nil == ParseDate(c.Date) {
+				report(-1, "date", "unrecognized date %q", c.Date)
+			}
+		}
+		empty := true
+		for j, line := range c.Description {
+			line = strings.TrimSpace(line)
+			if "" == line {
+				continue
+			}
+			empty = false
+			if len(rules.Categories) > 0 {
+				text, ok := trimCategory(line, rules.Categories)
+				if !ok {
+					report(j, "category", "line has no category (%s)",
+						strings.Join(rules.Categories, ", "))
+				}
+				line = text
+			}
+			if rules.Imperative && !isBreaking(line) {
+				if word := firstWord(line); !isImperative(word) {
+					report(j, "imperative", "%q is not in the imperative mood", word)
+				}
+			}
+		}
+		if rules.RequireDescription && empty {
+			report(-1, "description", "entry has no description")
+		}
+	}
+	return list
+}
+
+// trimCategory returns the given description line without its category prefix
+// (e.g., "Fixed: "), if it has one of the given categories.
+func trimCategory(line string, categories []string) (string, bool) {
+	for _, name := range categories {
+		if len(line) > len(name)+1 && strings.EqualFold(line[:len(name)], name) &&
+			':' == line[len(name)] {
+			return strings.TrimSpace(line[len(name)+1:]), true
+		}
+	}
+	return line, false
+}
+
+// firstWord returns the leading letters of the given string.
+func firstWord(s string) string {
+	for i, r := range s {
+		if !unicode.IsLetter(r) {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// isImperative returns false if the given word appears to be a verb in the past
+// tense ("Added"), third person ("Adds"), or progressive ("Adding"). It is a
+// heuristic for English; words it cannot judge are considered imperative.
+func isImperative(word string) bool {
+	w := strings.ToLower(word)
+	switch {
+	case len(w) < 4:
+		return true
+	case strings.HasSuffix(w, "eed"): // "need", "proceed"
+		return true
+	case strings.HasSuffix(w, "ed"), strings.HasSuffix(w, "ing"):
+		return false
+	case strings.HasSuffix(w, "ss"), strings.HasSuffix(w, "us"), strings.HasSuffix(w, "is"):
+		return true // "process", "focus", "analysis"
+	case strings.HasSuffix(w, "s"):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleHistory_Lint() {
+	h := version.History{
+		{Version: "1.0.0", Date: "2020-02-26", Title: "First", Description: []string{
+			"Added: support for widgets", "Fixed: crash on startup",
+		}},
+		{Version: "1.1.0", Title: "A title that is much too long", Description: []string{
+			"Adds gadgets", "process widgets in parallel", "BREAKING CHANGE: removed Foo",
+		}},
+		{Version: "1.2.0-rc.1"},
+	}
+	rules := version.DefaultLintRules
+	rules.MaxTitleLength = 16
+	rules.Categories = []string{"Added", "Changed", "Fixed"}
+	for _, f := range h.Lint(rules) {
+		fmt.Println(f)
+	}
+	// Output:
+	// entry 1 (1.1.0): [title] title has 29 characters, exceeding 16
+	// entry 1 (1.1.0): [date] released version has no date
+	// entry 1 (1.1.0) line 0: [category] line has no category (Added, Changed, Fixed)
+	// entry 1 (1.1.0) line 0: [imperative] "Adds" is not in the imperative mood
+	// entry 1 (1.1.0) line 1: [category] line has no category (Added, Changed, Fixed)
+	// entry 1 (1.1.0) line 2: [category] line has no category (Added, Changed, Fixed)
+	// entry 2 (1.2.0-rc.1): [description] entry has no description
+}
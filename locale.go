@@ -0,0 +1,113 @@
+package version
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Locale defines the localized names of months and weekdays recognized by
+// ParseDate when listed in DateLocales. Names are matched case-insensitively
+// and may be followed by a period (e.g., "Jan.").
+type Locale struct {
+	Name     string
+	Months   [12][]string // full and abbreviated names of each month, January first
+	Weekdays [7][]string  // full and abbreviated names of each weekday, Sunday first
+	Ignore   []string     // filler words to discard, such as "de" in Spanish
+}
+
+// Locales with month and weekday names for use in DateLocales.
+var (
+	German = Locale{
+		Name: "de",
+		Months: [12][]string{
+			{"Januar", "Jänner", "Jan", "Jän"}, {"Februar", "Feb"}, {"März", "Mär", "Maerz"},
+			{"April", "Apr"}, {"Mai"}, {"Juni", "Jun"}, {"Juli", "Jul"},
+			{"August", "Aug"}, {"September", "Sep", "Sept"}, {"Oktober", "Okt"},
+			{"November", "Nov"}, {"Dezember", "Dez"},
+		},
+		Weekdays: [7][]string{
+			{"Sonntag", "So"}, {"Montag", "Mo"}, {"Dienstag", "Di"}, {"Mittwoch", "Mi"},
+			{"Donnerstag", "Do"}, {"Freitag", "Fr"}, {"Samstag", "Sonnabend", "Sa"},
+		},
+	}
+	Spanish = Locale{
+		Name: "es",
+		Months: [12][]string{
+			{"enero", "ene"}, {"febrero", "feb"}, {"marzo", "mar"}, {"abril", "abr"},
+			{"mayo", "may"}, {"junio", "jun"}, {"julio", "jul"}, {"agosto", "ago"},
+			{"septiembre", "setiembre", "sep", "sept", "set"}, {"octubre", "oct"},
+			{"noviembre", "nov"}, {"diciembre", "dic"},
+		},
+		Weekdays: [7][]string{
+			{"domingo", "dom"}, {"lunes", "lun"}, {"martes", "mar"}, {"miércoles", "mié"},
+			{"jueves", "jue"}, {"viernes", "vie"}, {"sábado", "sáb"},
+		},
+		Ignore: []string{"de", "del"},
+	}
+	French = Locale{
+		Name: "fr",
+		Months: [12][]string{
+			{"janvier", "janv"}, {"février", "févr", "fevrier"}, {"mars"},
+			{"avril", "avr"}, {"mai"}, {"juin"}, {"juillet", "juil"}, {"août", "aout"},
+			{"septembre", "sept"}, {"octobre", "oct"}, {"novembre", "nov"},
+			{"décembre", "déc", "decembre"},
+		},
+		Weekdays: [7][]string{
+			{"dimanche", "dim"}, {"lundi", "lun"}, {"mardi", "mar"}, {"mercredi", "mer"},
+			{"jeudi", "jeu"}, {"vendredi", "ven"}, {"samedi", "sam"},
+		},
+	}
+)
+
+// DateLocales lists the locales whose month and weekday names are recognized
+// by ParseDate, in addition to English. For example:
+//
+//	version.DateLocales = []version.Locale{version.German, version.Spanish}
+//
+// A date-time string is only translated if it cannot be parsed as English.
+var DateLocales []Locale
+
+// translate returns the given date-time string with each of the locale's month
+// names replaced by its English name, and each weekday name, filler word, and
+// ordinal period (e.g., "9." in German) removed.
+func (l Locale) translate(date string) string {
+	fields := strings.Fields(date)
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		// separate trailing punctuation from the word
+		word := strings.TrimRightFunc(f, func(r rune) bool { return '.' == r || ',' == r })
+		punct := strings.TrimPrefix(f[len(word):], ".")
+		if isNumeric(word) {
+			out = append(out, word+punct) // drop ordinal period
+			continue
+		}
+		if m, ok := l.match(word, l.Months[:]); ok {
+			out = append(out, time.Month(m+1).String()+punct)
+			continue
+		}
+		if _, ok := l.match(word, l.Weekdays[:]); ok {
+			continue
+		}
+		if _, ok := l.match(word, [][]string{l.Ignore}); ok {
+			continue
+		}
+		out = append(out, f)
+	}
+	return strings.Join(out, " ")
+}
+
+// match returns the index of the list in names containing word, ignoring case.
+func (l Locale) match(word string, names [][]string) (int, bool) {
+	if "" == word || !unicode.IsLetter([]rune(word)[0]) {
+		return 0, false
+	}
+	for i, list := range names {
+		for _, name := range list {
+			if strings.EqualFold(word, name) {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
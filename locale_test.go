@@ -0,0 +1,31 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestDateLocales(t *testing.T) {
+	locales := version.DateLocales
+	defer func() { version.DateLocales = locales }()
+
+	dates := []string{
+		"9. März 2020", "Montag, 9. März 2020", "9 de marzo de 2020",
+		"marzo 9, 2020", "lundi 9 mars 2020",
+	}
+	version.DateLocales = nil
+	for _, s := range dates {
+		if nil != version.ParseDate(s) {
+			t.Errorf("ParseDate(%q) recognized without DateLocales", s)
+		}
+	}
+	version.DateLocales = []version.Locale{version.German, version.Spanish, version.French}
+	want := time.Date(2020, time.March, 9, 0, 0, 0, 0, time.UTC)
+	for _, s := range dates {
+		if got := version.ParseDate(s); nil == got || !got.Equal(want) {
+			t.Errorf("ParseDate(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
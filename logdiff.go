@@ -0,0 +1,163 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChangeLogDiff describes the differences between two changelogs, as reported
+// by CompareChangeLogs. Each entry is identified by its version, prefixed with
+// its Module and "@" if it is scoped to a module.
+type ChangeLogDiff struct {
+	Removed   []string // entries present only in the first changelog
+	Added     []string // entries present only in the second changelog
+	Changed   []string // entries present in both whose descriptions differ
+	Reordered []string // entries present in both whose relative order differs
+	Duplicate []string // entries appearing more than once in either changelog
+}
+
+// Empty returns true if and only if no differences are described by d.
+func (d ChangeLogDiff) Empty() bool {
+	return 0 == len(d.Removed)+len(d.Added)+len(d.Changed)+len(d.Reordered)+
+		len(d.Duplicate)
+}
+
+// String returns a report of the differences described by d, with one line per
+// entry prefixed by "-" (removed), "+" (added), "~" (changed), "^"
+// (reordered), or "!" (duplicate). Returns an empty string if d is empty.
+func (d ChangeLogDiff) String() string {
+	b := strings.Builder{}
+	for _, s := range []struct {
+		mark string
+		keys []string
+		what string
+	}{
+		{"-", d.Removed, "removed"},
+		{"+", d.Added, "added"},
+		{"~", d.Changed, "description changed"},
+		{"^", d.Reordered, "reordered"},
+		{"!", d.Duplicate, "duplicate entry"},
+	} {
+		for _, k := range s.keys {
+			fmt.Fprintf(&b, "%s %s: %s\n", s.mark, k, s.what)
+		}
+	}
+	return b.String()
+}
+
+// changeKey returns the identifier of Change c used by CompareChangeLogs.
+func changeKey(c *Change) string {
+	if "" != c.Module {
+		return c.Module + "@" + c.Version
+	}
+	return c.Version
+}
+
+// CompareChangeLogs returns the differences between changelogs a and b, such as
+// the file on a main branch and the same file on a release branch. Entries are
+// matched by Module and Version; descriptions are compared line by line,
+// ignoring leading and trailing whitespace. An entry is reordered if it must
+// move to make the common entries of a and b appear in the same order.
+// An entry appearing more than once in either changelog is reported as a
+// duplicate, and only its first occurrence is otherwise compared.
+func CompareChangeLogs(a, b []Change) ChangeLogDiff {
+	var d ChangeLogDiff
+	dup := map[string]bool{}
+	index := func(log []Change) map[string]*Change {
+		m := make(map[string]*Change, len(log))
+		for i := range log {
+			k := changeKey(&log[i])
+			if _, ok := m[k]; !ok {
+				m[k] = &log[i]
+			} else if !dup[k] {
+				dup[k] = true
+				d.Duplicate = append(d.Duplicate, k)
+			}
+		}
+		return m
+	}
+	inA, inB := index(a), index(b)
+	var common []string // keys in both a and b, in the order of a
+	for i := range a {
+		k := changeKey(&a[i])
+		if inA[k] != &a[i] {
+			continue // duplicate
+		}
+		if cb, ok := inB[k]; !ok {
+			d.Removed = append(d.Removed, k)
+		} else {
+			common = append(common, k)
+			da, db := canonical(a[i]).Description, canonical(*cb).Description
+			if strings.Join(da, "\n") != strings.Join(db, "\n") || len(da) != len(db) {
+				d.Changed = append(d.Changed, k)
+			}
+		}
+	}
+	var order []string // keys in both a and b, in the order of b
+	for i := range b {
+		k := changeKey(&b[i])
+		if inB[k] != &b[i] {
+			continue // duplicate
+		}
+		if _, ok := inA[k]; !ok {
+			d.Added = append(d.Added, k)
+		} else {
+			order = append(order, k)
+		}
+	}
+	d.Reordered = displaced(common, order)
+	return d
+}
+
+// CompareChangeLogFiles returns the differences between the changelog files at
+// paths a and b (see LoadChangeLog and CompareChangeLogs).
+// Returns an error if either file could not be read or decoded.
+func CompareChangeLogFiles(a, b string) (ChangeLogDiff, error) {
+	la, err := LoadChangeLog(a)
+	if nil != err {
+		return ChangeLogDiff{}, err
+	}
+	lb, err := LoadChangeLog(b)
+	if nil != err {
+		return ChangeLogDiff{}, err
+	}
+	return CompareChangeLogs(la, lb), nil
+}
+
+// displaced returns the elements of b that are not part of a longest common
+// subsequence of a and b; i.e., when b is a permutation of a, the fewest
+// elements that must move for b to have the same order as a.
+func displaced(a, b []string) []string {
+	m, n := len(a), len(b)
+	// lcs[i][j] is the length of the longest common subsequence of a[i:], b[j:]
+	lcs := make([][]int, m+1)
+	for i := range lcs {
+		lcs[i] = make([]int, n+1)
+	}
+	for i := m - 1; i >= 0; i-- {
+		for j := n - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var moved []string
+	i, j := 0, 0
+	for j < n {
+		switch {
+		case i < m && a[i] == b[j]:
+			i, j = i+1, j+1
+		case i < m && lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			moved = append(moved, b[j])
+			j++
+		}
+	}
+	return moved
+}
@@ -0,0 +1,54 @@
+package version_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleCompareChangeLogs() {
+	main := []version.Change{
+		{Version: "1.0.0", Description: []string{"first"}},
+		{Version: "1.1.0", Description: []string{"second"}},
+		{Version: "1.2.0", Description: []string{"third"}},
+	}
+	release := []version.Change{
+		{Version: "1.0.0", Description: []string{"first "}},
+		{Version: "1.1.0", Description: []string{"second", "fixup"}},
+		{Version: "1.1.1", Description: []string{"hotfix"}},
+	}
+	fmt.Print(version.CompareChangeLogs(main, release))
+	// Output:
+	// - 1.2.0: removed
+	// + 1.1.1: added
+	// ~ 1.1.0: description changed
+}
+
+func TestCompareChangeLogsReordered(t *testing.T) {
+	log := func(versions ...string) []version.Change {
+		var l []version.Change
+		for _, v := range versions {
+			l = append(l, version.Change{Module: "m", Version: v})
+		}
+		return l
+	}
+	a := log("1.0.0", "1.1.0", "1.2.0", "1.3.0")
+	d := version.CompareChangeLogs(a, log("1.0.0", "1.2.0", "1.3.0", "1.1.0"))
+	if want := []string{"m@1.1.0"}; !reflect.DeepEqual(d.Reordered, want) {
+		t.Errorf("Reordered = %q, want %q", d.Reordered, want)
+	}
+	if d := version.CompareChangeLogs(a, a); !d.Empty() {
+		t.Errorf("identical changelogs differ:\n%s", d)
+	}
+
+	// duplicates are reported rather than compared
+	d = version.CompareChangeLogs(log("1.0.0", "1.0.0", "1.1.0"), log("1.1.0", "1.0.0"))
+	if want := []string{"m@1.0.0"}; !reflect.DeepEqual(d.Duplicate, want) {
+		t.Errorf("Duplicate = %q, want %q", d.Duplicate, want)
+	}
+	if want := []string{"m@1.0.0"}; !reflect.DeepEqual(d.Reordered, want) {
+		t.Errorf("Reordered = %q, want %q", d.Reordered, want)
+	}
+}
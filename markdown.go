@@ -0,0 +1,190 @@
+package version
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReadMarkdown decodes from given io.Reader r a changelog in the Markdown format
+// described by https://keepachangelog.com, returning its entries ordered from
+// oldest to newest. Each release is a level-2 heading containing the version,
+// optionally followed by the date, a title, and the marker "[YANKED]":
+//
+//	## [1.2.0] - 2020-03-09
+//	### Added
+//	- new feature
+//
+// Each list item becomes a line of the description. Items beneath a level-3
+// category heading are prefixed with the category name (e.g., "Added: new
+// feature"). A section named "Unreleased" and all other content are ignored.
+func ReadMarkdown(r io.Reader) ([]Change, error) {
+	var log []Change
+	var cur *Change
+	category := ""
+	item := false // true if the previous line was part of a list item
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimRight(s.Text(), " \t")
+		trim := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "## "):
+			c, ok, err := parseMarkdownHeading(line[3:])
+			if nil != err {
+				return nil, fmt.Errorf("line %d: %v", n, err)
+			}
+			cur, category, item = nil, "", false
+			if ok {
+				log = append(log, c)
+				cur = &log[len(log)-1]
+			}
+		case nil == cur:
+			// ignore content outside of a release
+		case strings.HasPrefix(line, "### "):
+			category, item = strings.TrimSpace(line[4:]), false
+		case strings.HasPrefix(line, "- "), strings.HasPrefix(line, "* "):
+			text := strings.TrimSpace(line[2:])
+			if "" != category {
+				text = category + ": " + text
+			}
+			cur.Description = append(cur.Description, text)
+			item = true
+		case item && "" != trim && line != trim:
+			// indented continuation of the previous list item
+			last := &cur.Description[len(cur.Description)-1]
+			*last += " " + trim
+		default:
+			item = false
+		}
+	}
+	if err := s.Err(); nil != err {
+		return nil, err
+	}
+	// releases are listed newest first
+	for i, j := 0, len(log)-1; i < j; i, j = i+1, j-1 {
+		log[i], log[j] = log[j], log[i]
+	}
+	return log, nil
+}
+
+// parseMarkdownHeading parses the text of a release heading, such as
+// "[1.2.0] - 2020-03-09 - Title [YANKED]". Returns false if the heading is the
+// "Unreleased" section.
+func parseMarkdownHeading(text string) (Change, bool, error) {
+	var c Change
+	text = strings.TrimSpace(text)
+	if strings.HasSuffix(text, "[YANKED]") {
+		c.Yanked = true
+		text = strings.TrimSpace(strings.TrimSuffix(text, "[YANKED]"))
+	}
+	var ver string
+	if strings.HasPrefix(text, "[") {
+		end := strings.IndexByte(text, ']')
+		if end < 0 {
+			return c, false, fmt.Errorf("unterminated version in heading: %s", text)
+		}
+		ver, text = text[1:end], text[end+1:]
+	} else {
+		ver, text = text, ""
+		if i := strings.IndexAny(ver, " \t"); i >= 0 {
+			ver, text = ver[:i], ver[i:]
+		}
+	}
+	if strings.EqualFold("Unreleased", ver) {
+		return c, false, nil
+	}
+	v, err := ParseTolerant(ver)
+	if nil != err {
+		return c, false, err
+	}
+	c.Version = v.String()
+	var fields []string
+	for _, f := range strings.Split(text, " - ") {
+		if f = strings.TrimSpace(f); "" != f {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) > 0 && nil != ParseDate(fields[0]) {
+		c.Date, fields = fields[0], fields[1:]
+	}
+	c.Title = strings.Join(fields, " - ")
+	return c, true, nil
+}
+
+// markdownCategories lists the categories defined by https://keepachangelog.com
+// in the order they are written by WriteMarkdown.
+var markdownCategories = []string{
+	"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security",
+}
+
+// splitCategory returns the category prefixing the given description line
+// (e.g., "Added" for "Added: new feature"), as written by WriteMarkdown, and
+// the remaining text. The category is empty if the line has none.
+func splitCategory(line string) (category, text string) {
+	for _, name := range markdownCategories {
+		if strings.HasPrefix(line, name+": ") {
+			return name, line[len(name)+2:]
+		}
+	}
+	return "", line
+}
+
+// WriteMarkdown encodes to given io.Writer w the given Change entries, ordered
+// from oldest to newest, as a Markdown changelog in the format described by
+// https://keepachangelog.com and read by ReadMarkdown. Releases are written
+// newest first. Description lines prefixed with a category name (e.g., "Fixed:
+// a bug") are grouped beneath a heading for that category, and Deprecations are
+// listed beneath "Deprecated". Dates are written as YYYY-MM-DD where recognized.
+// Fields with no Markdown representation, such as Package, Module, Breaking,
+// Authors, Links, Artifacts, and Template, are not written (see NewFileStore).
+func WriteMarkdown(w io.Writer, log []Change) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("# Changelog\n")
+	for i := len(log) - 1; i >= 0; i-- {
+		b.WriteString("\n")
+		writeMarkdownEntry(b, &log[i], 2)
+	}
+	return b.Flush()
+}
+
+// writeMarkdownEntry writes to b the Markdown section describing Change c, as
+// written by WriteMarkdown, with a heading of the given level (e.g., 2 for
+// "##"). Category headings are one level lower.
+func writeMarkdownEntry(b *bufio.Writer, c *Change, level int) {
+	heading := strings.Repeat("#", level)
+	fmt.Fprintf(b, "%s [%s]", heading, c.Version)
+	if t := ParseDate(c.Date); nil != t {
+		fmt.Fprintf(b, " - %s", t.Format("2006-01-02"))
+	} else if "" != c.Date {
+		fmt.Fprintf(b, " - %s", c.Date)
+	}
+	if "" != c.Title {
+		fmt.Fprintf(b, " - %s", c.Title)
+	}
+	if c.Yanked {
+		b.WriteString(" [YANKED]")
+	}
+	b.WriteString("\n")
+
+	// group description lines by category
+	items := map[string][]string{}
+	for _, line := range c.Description {
+		cat, text := splitCategory(line)
+		items[cat] = append(items[cat], text)
+	}
+	for _, d := range c.Deprecations {
+		items["Deprecated"] = append(items["Deprecated"], d.String())
+	}
+	for _, line := range items[""] {
+		fmt.Fprintf(b, "- %s\n", line)
+	}
+	for _, cat := range markdownCategories {
+		if len(items[cat]) > 0 {
+			fmt.Fprintf(b, "%s# %s\n", heading, cat)
+			for _, line := range items[cat] {
+				fmt.Fprintf(b, "- %s\n", line)
+			}
+		}
+	}
+}
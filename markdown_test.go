@@ -0,0 +1,101 @@
+package version_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+const markdown = `# Changelog
+
+## [Unreleased]
+- work in progress
+
+## [1.1.0] - 2020-03-20 - Second [YANKED]
+### Added
+- a feature described
+  over two lines
+### Fixed
+- a bug
+
+## 1.0.0 - 2020-03-09
+- initial release
+
+[1.1.0]: https://example.com/compare/v1.0.0...v1.1.0
+`
+
+func ExampleReadMarkdown() {
+	log, err := version.ReadMarkdown(strings.NewReader(markdown))
+	if nil != err {
+		panic(err)
+	}
+	for _, c := range log {
+		fmt.Printf("%s %s %q yanked=%t\n", c.Version, c.Date, c.Title, c.Yanked)
+		for _, line := range c.Description {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+
+	// Output:
+	// 1.0.0 2020-03-09 "" yanked=false
+	//   initial release
+	// 1.1.0 2020-03-20 "Second" yanked=true
+	//   Added: a feature described over two lines
+	//   Fixed: a bug
+}
+
+func TestReadMarkdownInvalid(t *testing.T) {
+	for _, s := range []string{"## [1.2] - 2020-03-09\n", "## [1.2.0 - 2020-03-09\n"} {
+		if _, err := version.ReadMarkdown(strings.NewReader(s)); nil == err {
+			t.Errorf("ReadMarkdown(%q) = nil error", s)
+		}
+	}
+}
+
+func ExampleWriteMarkdown() {
+	log := []version.Change{
+		{Version: "1.0.0", Date: "Mar 9, 2020", Description: []string{"initial release"}},
+		{
+			Version: "1.1.0",
+			Date:    "2020-03-20",
+			Description: []string{
+				"Fixed: a bug",
+				"Added: a feature",
+			},
+			Deprecations: []version.Deprecation{{Feature: "Old", Removal: "2.0.0"}},
+		},
+	}
+	version.WriteMarkdown(os.Stdout, log)
+
+	// Output:
+	// # Changelog
+	//
+	// ## [1.1.0] - 2020-03-20
+	// ### Added
+	// - a feature
+	// ### Deprecated
+	// - Old (removal in 2.0.0)
+	// ### Fixed
+	// - a bug
+	//
+	// ## [1.0.0] - 2020-03-09
+	// - initial release
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	log, _ := version.ReadMarkdown(strings.NewReader(markdown))
+	var b strings.Builder
+	if err := version.WriteMarkdown(&b, log); nil != err {
+		t.Fatalf("WriteMarkdown() = %v", err)
+	}
+	again, err := version.ReadMarkdown(strings.NewReader(b.String()))
+	if nil != err {
+		t.Fatalf("ReadMarkdown(WriteMarkdown()) = %v", err)
+	}
+	if version.Digest(log) != version.Digest(again) {
+		t.Errorf("round trip changed changelog:\n%s", b.String())
+	}
+}
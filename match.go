@@ -0,0 +1,55 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Match returns true if and only if the given version matches the wildcard
+// pattern, such as "1.2.x", "2.*", or "*". A component of the pattern that is
+// "x", "X", or "*" matches any value, as do omitted trailing components (so
+// "1.2" is equivalent to "1.2.x"). Like ParseTolerant, a leading "v" is
+// permitted on both pattern and version. A prerelease version matches only a
+// pattern with no wildcards and an identical prerelease; build metadata is
+// ignored.
+// Returns false if either pattern or version is invalid.
+func Match(pattern, version string) bool {
+	v, err := ParseTolerant(version)
+	if nil != err {
+		return false
+	}
+	p := strings.TrimSpace(pattern)
+	if strings.HasPrefix(p, "v") || strings.HasPrefix(p, "V") {
+		p = p[1:]
+	}
+	if i := strings.IndexByte(p, '+'); i >= 0 {
+		p = p[:i]
+	}
+	pre := ""
+	if i := strings.IndexByte(p, '-'); i >= 0 {
+		p, pre = p[:i], p[i+1:]
+	}
+	parts := strings.Split(p, ".")
+	if len(parts) > 3 {
+		return false
+	}
+	exact := 3 == len(parts)
+	for i, comp := range [3]uint{v.Major, v.Minor, v.Patch} {
+		if i >= len(parts) {
+			break
+		}
+		switch parts[i] {
+		case "x", "X", "*":
+			exact = false
+			continue
+		}
+		n, err := strconv.ParseUint(parts[i], 10, 0)
+		if nil != err || uint(n) != comp {
+			return false
+		}
+	}
+	if !exact {
+		return "" == pre && "" == v.Prerelease
+	}
+	return pre == v.Prerelease
+}
@@ -0,0 +1,33 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, version string
+		want             bool
+	}{
+		{"*", "3.4.5", true},
+		{"1.2.x", "1.2.9", true},
+		{"1.2.X", "1.3.0", false},
+		{"2.*", "2.9.1+build.5", true},
+		{"2.*", "3.0.0", false},
+		{"v1.2", "v1.2.7", true},
+		{"1.x.3", "1.8.3", true},
+		{"1.x.3", "1.8.4", false},
+		{"1.2.x", "1.2.3-rc.1", false},
+		{"1.2.3-rc.1", "1.2.3-rc.1+x", true},
+		{"1.2.3", "1.2.3-rc.1", false},
+		{"1.2.3.4", "1.2.3", false},
+		{"1.y", "1.2.3", false},
+		{"1.x", "bogus", false},
+	} {
+		if got := version.Match(tc.pattern, tc.version); got != tc.want {
+			t.Errorf("Match(%q, %q) = %t, want %t", tc.pattern, tc.version, got, tc.want)
+		}
+	}
+}
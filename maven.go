@@ -0,0 +1,273 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mavenItem is a number, a qualifier, or a list of items of a Maven version
+// string. A list begins at each '-' and at each transition between digits and
+// letters, and it contains the remaining items of the version.
+type mavenItem struct {
+	text    string // lowercase qualifier, or number without leading zeros
+	numeric bool
+	list    bool
+	items   []*mavenItem // items of a list
+}
+
+// mavenAlias maps each alternative spelling of a Maven qualifier to its
+// canonical form. Qualifiers denoting a release map to the empty string.
+var mavenAlias = map[string]string{
+	"a": "alpha", "b": "beta", "m": "milestone", "cr": "rc",
+	"ga": "", "final": "", "release": "",
+}
+
+// mavenRank orders the well-known canonical Maven qualifiers. Unknown
+// qualifiers follow every well-known qualifier, ordered lexically.
+var mavenRank = map[string]int{
+	"alpha": 1, "beta": 2, "milestone": 3, "rc": 4, "snapshot": 5, "": 6, "sp": 7,
+}
+
+// newMavenItem returns the number or canonical qualifier s.
+func newMavenItem(s string) *mavenItem {
+	if isNumeric(s) {
+		s = strings.TrimLeft(s, "0")
+		if "" == s {
+			s = "0"
+		}
+		return &mavenItem{text: s, numeric: true}
+	}
+	if alias, ok := mavenAlias[s]; ok {
+		s = alias
+	}
+	return &mavenItem{text: s}
+}
+
+// parseMaven returns the list of items of the given Maven version string, as
+// parsed by Maven's ComparableVersion, without removing insignificant items.
+func parseMaven(version string) *mavenItem {
+	root := &mavenItem{list: true}
+	list := root
+	add := func(s string) { list.items = append(list.items, newMavenItem(s)) }
+	nest := func() {
+		sub := &mavenItem{list: true}
+		list.items = append(list.items, sub)
+		list = sub
+	}
+	s := strings.ToLower(strings.TrimSpace(version))
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '.' == c, '-' == c:
+			if i == start {
+				add("0") // empty component
+			} else {
+				add(s[start:i])
+			}
+			start = i + 1
+			if '-' == c {
+				nest()
+			}
+		case i > start && isDigit(c) != isDigit(s[i-1]):
+			add(s[start:i])
+			start = i
+			nest()
+		}
+	}
+	if start < len(s) {
+		add(s[start:])
+	}
+	return root
+}
+
+// normalize removes the trailing zeros and release qualifiers, which are not
+// significant (e.g., "1.0-rc" == "1-rc"), from list l and each list it
+// contains. Returns l.
+func (l *mavenItem) normalize() *mavenItem {
+	for _, it := range l.items {
+		if it.list {
+			it.normalize()
+		}
+	}
+	for i := len(l.items) - 1; i >= 0; i-- {
+		switch it := l.items[i]; {
+		case it.list:
+			continue
+		case "0" == it.text || "" == it.text:
+			l.items = append(l.items[:i], l.items[i+1:]...)
+		default:
+			return l
+		}
+	}
+	return l
+}
+
+// isDigit returns true if and only if c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// compareMavenItem compares two items of Maven versions, either of which may
+// be nil if the version has fewer items. Numbers follow lists, which follow
+// qualifiers.
+func compareMavenItem(a, b *mavenItem) int {
+	switch {
+	case nil == a && nil == b:
+		return 0
+	case nil == a:
+		return -compareMavenItem(b, a)
+	case a.list && nil == b:
+		if 0 == len(a.items) {
+			return 0
+		}
+		return compareMavenItem(a.items[0], nil)
+	case a.list && b.list:
+		for i := 0; i < len(a.items) || i < len(b.items); i++ {
+			var x, y *mavenItem
+			if i < len(a.items) {
+				x = a.items[i]
+			}
+			if i < len(b.items) {
+				y = b.items[i]
+			}
+			if c := compareMavenItem(x, y); 0 != c {
+				return c
+			}
+		}
+		return 0
+	case a.list:
+		if b.numeric {
+			return -1
+		}
+		return 1
+	case nil == b:
+		if a.numeric {
+			if "0" == a.text {
+				return 0 // missing number is zero
+			}
+			return 1
+		}
+		return compareMavenItem(a, &mavenItem{}) // missing qualifier is a release
+	case b.list:
+		return -compareMavenItem(b, a)
+	case a.numeric && b.numeric:
+		if len(a.text) != len(b.text) {
+			return compareUint(uint(len(a.text)), uint(len(b.text)))
+		}
+		return strings.Compare(a.text, b.text)
+	case a.numeric:
+		return 1 // numbers follow qualifiers
+	case b.numeric:
+		return -1
+	}
+	ra, aok := mavenRank[a.text]
+	rb, bok := mavenRank[b.text]
+	switch {
+	case aok && bok:
+		return compareUint(uint(ra), uint(rb))
+	case aok:
+		return -1
+	case bok:
+		return 1
+	}
+	return strings.Compare(a.text, b.text)
+}
+
+// CompareMaven returns an integer comparing two Maven version strings, such
+// as "1.0-SNAPSHOT" and "1.0.1-alpha-2", in the order defined by Maven's
+// ComparableVersion: -1 if a < b, 0 if a == b, and +1 if a > b.
+//
+// Versions are split into numbers and qualifiers, compared in turn. Numbers
+// follow qualifiers, and well-known qualifiers are ordered alpha (a) < beta
+// (b) < milestone (m) < rc (cr) < snapshot < release (ga, final, or none) <
+// sp, followed by any other qualifiers in lexical order, case-insensitively.
+// Trailing zeros are insignificant ("1.0" == "1"). Each '-' begins a nested
+// list of the items that follow, which precedes any number in the same position
+// ("1-1" < "1.1"). Every string is a valid Maven version, so no error is
+// possible.
+func CompareMaven(a, b string) int {
+	return compareMavenItem(parseMaven(a).normalize(), parseMaven(b).normalize())
+}
+
+// FromMaven converts a Maven version string to a semantic version.
+//
+// The leading numbers, up to the first '-' or qualifier, are the release, padded
+// with zeros to three components, and the numbers and qualifiers that follow
+// become prerelease identifiers, in canonical lowercase form (e.g., "1.0-RC1" is "1.0.0-rc.1" and "2.1-SNAPSHOT" is
+// "2.1.0-snapshot"). Release qualifiers such as "Final" are dropped, and a
+// service pack ("sp"), which follows its release, is recorded in build metadata
+// ("1.0-sp1" is "1.0.0+sp.1"). Use CompareMaven to order Maven versions, since
+// semantic version precedence differs for some qualifiers.
+//
+// Returns an error if the release has more than three components or the result
+// is not a valid semantic version.
+func FromMaven(version string) (string, error) {
+	items := parseMaven(version).items
+	n := 0
+	for n < len(items) && items[n].numeric {
+		n++
+	}
+	release, rest := items[:n], items[n:]
+	for len(release) > 0 && "0" == release[len(release)-1].text {
+		release = release[:len(release)-1] // trailing zeros are not significant
+	}
+	var rel [3]uint
+	if len(release) > len(rel) {
+		return "", fmt.Errorf("Maven version %q has more than 3 release components", version)
+	}
+	for i, it := range release {
+		u, err := parseNumber(it.text)
+		if nil != err {
+			return "", fmt.Errorf("invalid Maven version %q: %v", version, err)
+		}
+		rel[i] = u
+	}
+	var pre, meta []string
+	var walk func(list []*mavenItem)
+	walk = func(list []*mavenItem) {
+		for _, it := range list {
+			switch {
+			case it.list:
+				walk(it.items)
+			case "sp" == it.text || len(meta) > 0:
+				meta = append(meta, it.text)
+			case "" != it.text:
+				pre = append(pre, it.text)
+			}
+		}
+	}
+	walk(rest)
+	s := format(rel[0], rel[1], rel[2], strings.Join(pre, "."), strings.Join(meta, "."))
+	if _, err := ParseSemver(s); nil != err {
+		return "", fmt.Errorf("Maven version %q cannot be represented: %v", version, err)
+	}
+	return s, nil
+}
+
+// ToMaven converts a semantic version string to a Maven version string. It is
+// the inverse of FromMaven: prerelease identifiers become hyphen-separated
+// qualifiers, with "snapshot" written as "SNAPSHOT" (e.g., "1.0.0-beta.2" is
+// "1.0.0-beta-2" and "1.0.0-snapshot" is "1.0.0-SNAPSHOT"), and a service pack
+// recorded in build metadata is restored. Other build metadata has no Maven
+// equivalent and is discarded.
+// Returns an error if the version is invalid.
+func ToMaven(version string) (string, error) {
+	v, err := ParseSemver(version)
+	if nil != err {
+		return "", err
+	}
+	ids := SplitIdentifiers(v.Prerelease)
+	if meta := SplitIdentifiers(v.Metadata); len(meta) > 0 && "sp" == meta[0] {
+		ids = append(ids, meta...)
+	}
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "%d.%d.%d", v.Major, v.Minor, v.Patch)
+	for _, id := range ids {
+		if strings.EqualFold("snapshot", id) {
+			id = "SNAPSHOT"
+		}
+		b.WriteRune('-')
+		b.WriteString(id)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,70 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestMaven(t *testing.T) {
+	for _, tc := range []struct {
+		maven, semver, normal string
+	}{
+		{"1.2.3", "1.2.3", "1.2.3"},
+		{"1.0-SNAPSHOT", "1.0.0-snapshot", "1.0.0-SNAPSHOT"},
+		{"2.0-RC1", "2.0.0-rc.1", "2.0.0-rc-1"},
+		{"1.0-alpha-01", "1.0.0-alpha.1", "1.0.0-alpha-1"},
+		{"5.4.2.Final", "5.4.2", "5.4.2"},
+		{"1.0-sp1", "1.0.0+sp.1", "1.0.0-sp-1"},
+		{"3", "3.0.0", "3.0.0"},
+		{"2.0.0-1", "2.0.0-1", "2.0.0-1"},
+		{"1.2.3-4", "1.2.3-4", "1.2.3-4"},
+		{"1.0.0-rc-0", "1.0.0-rc.0", "1.0.0-rc-0"},
+		{"1.2.0.0", "1.2.0", "1.2.0"},
+	} {
+		sv, err := version.FromMaven(tc.maven)
+		if nil != err || sv != tc.semver {
+			t.Errorf("FromMaven(%q) = %q, %v, want %q", tc.maven, sv, err, tc.semver)
+			continue
+		}
+		m, err := version.ToMaven(sv)
+		if nil != err || m != tc.normal {
+			t.Errorf("ToMaven(%q) = %q, %v, want %q", sv, m, err, tc.normal)
+		}
+		if 0 != version.CompareMaven(m, tc.maven) {
+			t.Errorf("CompareMaven(%q, %q) != 0", m, tc.maven)
+		}
+	}
+	if _, err := version.FromMaven("1.2.3.4"); nil == err {
+		t.Error("FromMaven(1.2.3.4): expected error")
+	}
+	for _, sv := range []string{"1.0.0-1", "1.0.0-rc.0", "1.2.3-4.5"} {
+		m, err := version.ToMaven(sv)
+		if nil != err {
+			t.Errorf("ToMaven(%q): %v", sv, err)
+			continue
+		}
+		if rt, err := version.FromMaven(m); nil != err || rt != sv {
+			t.Errorf("FromMaven(ToMaven(%q)) = %q, %v", sv, rt, err)
+		}
+	}
+
+	// each version precedes the next
+	order := []string{
+		"1-alpha", "1-a2", "1-beta-1", "1-m1", "1-rc", "1-SNAPSHOT", "1",
+		"1-sp", "1-xyz", "1-1", "1.0.1", "1.1-alpha", "1.1", "1.10",
+	}
+	for i := 0; i+1 < len(order); i++ {
+		if c := version.CompareMaven(order[i], order[i+1]); -1 != c {
+			t.Errorf("CompareMaven(%q, %q) = %d, want -1", order[i], order[i+1], c)
+		}
+		if c := version.CompareMaven(order[i+1], order[i]); 1 != c {
+			t.Errorf("CompareMaven(%q, %q) = %d, want 1", order[i+1], order[i], c)
+		}
+	}
+	for _, eq := range [][2]string{{"1", "1.0.0"}, {"1.0-GA", "1"}, {"1-CR1", "1-rc-1"}} {
+		if c := version.CompareMaven(eq[0], eq[1]); 0 != c {
+			t.Errorf("CompareMaven(%q, %q) = %d, want 0", eq[0], eq[1], c)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package version
+
+import (
+	"sort"
+	"time"
+)
+
+// MergeOptions configures MergeChangeLogs.
+type MergeOptions struct {
+	// ByDate orders entries by date, then by version precedence. Entries
+	// without a recognized date are ordered after all others. If false, entries
+	// are ordered by version precedence, then by date.
+	ByDate bool
+
+	// IgnorePackage considers entries with the same Module and version to be
+	// duplicates even if their Package differs. If false, only entries with the
+	// same Package, Module, and version are duplicates. Entries of different
+	// modules are never duplicates.
+	IgnorePackage bool
+}
+
+// MergeChangeLogs returns a new changelog containing the entries of each of the
+// given changelogs, ordered from oldest to newest as configured by opts. Use it
+// to build a combined release history from several components or branches.
+//
+// Duplicate entries (equal version precedence, see MergeOptions.IgnorePackage)
+// are combined into the first occurrence: missing fields are copied from later
+// occurrences, and description lines, authors, links, artifacts, and
+// deprecations not already present are appended.
+// It panics if any of the version strings are invalid.
+func MergeChangeLogs(a, b []Change, opts MergeOptions) []Change {
+	var merged []Change
+	for _, c := range append(append([]Change(nil), a...), b...) {
+		dup := -1
+		for i := range merged {
+			if (opts.IgnorePackage || merged[i].Package == c.Package) &&
+				merged[i].Module == c.Module &&
+				0 == compareVersions(merged[i].Version, c.Version) {
+				dup = i
+				break
+			}
+		}
+		if dup < 0 {
+			c.Description = append([]string(nil), c.Description...)
+			c.Authors = append([]string(nil), c.Authors...)
+			c.Links = append([]string(nil), c.Links...)
+			c.Artifacts = append([]Artifact(nil), c.Artifacts...)
+			c.Deprecations = append([]Deprecation(nil), c.Deprecations...)
+			merged = append(merged, c)
+			continue
+		}
+		m := &merged[dup]
+		if "" == m.Package {
+			m.Package = c.Package
+		}
+		if "" == m.Title {
+			m.Title = c.Title
+		}
+		if "" == m.Date {
+			m.Date = c.Date
+		}
+		if "" == m.Template {
+			m.Template = c.Template
+		}
+		m.Breaking = m.Breaking || c.Breaking
+		m.Yanked = m.Yanked || c.Yanked
+		m.Description = appendUnique(m.Description, c.Description...)
+		m.Authors = appendUnique(m.Authors, c.Authors...)
+		m.Links = appendUnique(m.Links, c.Links...)
+		for _, a := range c.Artifacts {
+			if !hasArtifact(m.Artifacts, a) {
+				m.Artifacts = append(m.Artifacts, a)
+			}
+		}
+		for _, d := range c.Deprecations {
+			if !hasDeprecation(m.Deprecations, d) {
+				m.Deprecations = append(m.Deprecations, d)
+			}
+		}
+	}
+
+	date := func(c Change) time.Time {
+		if t := ParseDate(c.Date); nil != t {
+			return *t
+		}
+		return time.Unix(1<<62, 0) // after all recognized dates
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		di, dj := date(merged[i]), date(merged[j])
+		cv := compareVersions(merged[i].Version, merged[j].Version)
+		if opts.ByDate {
+			if !di.Equal(dj) {
+				return di.Before(dj)
+			}
+			return cv < 0
+		}
+		if 0 != cv {
+			return cv < 0
+		}
+		return di.Before(dj)
+	})
+	return merged
+}
+
+// hasArtifact returns true if and only if list contains Artifact a.
+func hasArtifact(list []Artifact, a Artifact) bool {
+	for _, e := range list {
+		if e == a {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDeprecation returns true if and only if list contains Deprecation d.
+func hasDeprecation(list []Deprecation, d Deprecation) bool {
+	for _, e := range list {
+		if e == d {
+			return true
+		}
+	}
+	return false
+}
+
+// appendUnique appends to list each of the given strings not already in list.
+func appendUnique(list []string, s ...string) []string {
+	for _, e := range s {
+		found := false
+		for _, l := range list {
+			if l == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, e)
+		}
+	}
+	return list
+}
@@ -0,0 +1,86 @@
+package version_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleMergeChangeLogs() {
+	main := []version.Change{
+		{Version: "1.0.0", Date: "2020-01-10", Description: []string{"initial release"}},
+		{Version: "1.1.0", Date: "2020-03-01", Description: []string{"add Dude"}},
+	}
+	backport := []version.Change{
+		{Version: "1.0.1", Date: "2020-03-15", Description: []string{"fix Sweet"}},
+		{Version: "1.1.0", Title: "Red Label", Description: []string{"add Dude", "fix Sweet"}},
+	}
+	for _, byDate := range []bool{false, true} {
+		for _, c := range version.MergeChangeLogs(main, backport, version.MergeOptions{ByDate: byDate}) {
+			fmt.Printf("%s %q %s %q\n", c.Version, c.Title, c.Date, c.Description)
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// 1.0.0 "" 2020-01-10 ["initial release"]
+	// 1.0.1 "" 2020-03-15 ["fix Sweet"]
+	// 1.1.0 "Red Label" 2020-03-01 ["add Dude" "fix Sweet"]
+	//
+	// 1.0.0 "" 2020-01-10 ["initial release"]
+	// 1.1.0 "Red Label" 2020-03-01 ["add Dude" "fix Sweet"]
+	// 1.0.1 "" 2020-03-15 ["fix Sweet"]
+}
+
+func TestMergeChangeLogsAttachments(t *testing.T) {
+	linux := version.Artifact{Name: "tool", Platform: "linux/amd64"}
+	darwin := version.Artifact{Name: "tool", Platform: "darwin/amd64"}
+	old := version.Deprecation{Feature: "Old", Removal: "2.0.0"}
+	a := []version.Change{{Version: "1.0.0", Artifacts: []version.Artifact{linux}}}
+	b := []version.Change{{
+		Version:      "1.0.0",
+		Artifacts:    []version.Artifact{linux, darwin},
+		Deprecations: []version.Deprecation{old},
+	}}
+	m := version.MergeChangeLogs(a, b, version.MergeOptions{})
+	if 1 != len(m) {
+		t.Fatalf("len(merged) = %d, want 1", len(m))
+	}
+	if want := []version.Artifact{linux, darwin}; !reflect.DeepEqual(m[0].Artifacts, want) {
+		t.Errorf("Artifacts = %v, want %v", m[0].Artifacts, want)
+	}
+	if want := []version.Deprecation{old}; !reflect.DeepEqual(m[0].Deprecations, want) {
+		t.Errorf("Deprecations = %v, want %v", m[0].Deprecations, want)
+	}
+	if 1 != len(a[0].Artifacts) {
+		t.Errorf("MergeChangeLogs modified its input")
+	}
+}
+
+func TestMergeChangeLogsModules(t *testing.T) {
+	a := []version.Change{{Package: "app", Version: "1.0.0", Description: []string{"root"}}}
+	b := []version.Change{
+		{Package: "app", Module: "app/sub", Version: "1.0.0", Description: []string{"sub"}},
+		{Package: "app", Version: "1.0.0", Template: "plain"},
+	}
+	for _, ignore := range []bool{false, true} {
+		m := version.MergeChangeLogs(a, b, version.MergeOptions{IgnorePackage: ignore})
+		if 2 != len(m) {
+			t.Fatalf("IgnorePackage=%t: len(merged) = %d, want 2", ignore, len(m))
+		}
+		for _, c := range m {
+			want := []string{"root"}
+			if "app/sub" == c.Module {
+				want = []string{"sub"}
+			} else if "plain" != c.Template {
+				t.Errorf("IgnorePackage=%t: root Template = %q, want plain", ignore, c.Template)
+			}
+			if !reflect.DeepEqual(c.Description, want) {
+				t.Errorf("IgnorePackage=%t: module %q Description = %q, want %q",
+					ignore, c.Module, c.Description, want)
+			}
+		}
+	}
+}
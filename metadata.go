@@ -0,0 +1,145 @@
+package version
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Metadata composes the build metadata component of a semantic version (the
+// dot-separated identifiers following "+") from key/value pairs, such as
+// "sha.abc1234.date.20200309174523.builder.ci". Each method returns a new
+// Metadata with the given pair appended, so calls may be chained:
+//
+//	meta := version.NewMetadata().WithCommit("abc1234").WithBuilder("ci")
+//	v.Metadata = meta.String()
+//
+// ParseMetadata reads the pairs back from a version string.
+type Metadata []string
+
+// Keys used by the Metadata methods.
+const (
+	MetadataCommit    = "sha"
+	MetadataBuildDate = "date"
+	MetadataBuilder   = "builder"
+)
+
+// MetadataDateFormat defines the format of the date-time appended by
+// Metadata.WithBuildDate. It contains only characters valid in an identifier.
+const MetadataDateFormat = "20060102150405"
+
+// NewMetadata returns an empty Metadata.
+func NewMetadata() Metadata {
+	return Metadata{}
+}
+
+// ParseMetadata parses the dot-separated key/value pairs of a build metadata
+// component, such as "sha.abc123.build.42", as composed by Metadata. The given
+// string may be the metadata component, with or without its leading "+", or a
+// complete version string, in which case only its build metadata is used (a
+// version without "+" has none).
+// Returns an error if the component has an odd number of identifiers or any
+// identifier is empty or contains characters other than [0-9A-Za-z-].
+func ParseMetadata(s string) (Metadata, error) {
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[i+1:]
+	} else if v, ok := scanSemver(s); ok {
+		s = v.Metadata
+	}
+	if "" == s {
+		return NewMetadata(), nil
+	}
+	m := Metadata(strings.Split(s, "."))
+	for _, id := range m {
+		if "" == id || id != identifier(id) {
+			return nil, fmt.Errorf("invalid build metadata %q: invalid identifier %q", s, id)
+		}
+	}
+	if 0 != len(m)%2 {
+		return nil, fmt.Errorf("invalid build metadata %q: key %q has no value", s, m[len(m)-1])
+	}
+	return m, nil
+}
+
+// MetadataFromMap returns a Metadata composed of each key/value pair in the
+// given map, ordered by key so that the result is deterministic.
+func MetadataFromMap(pairs map[string]string) Metadata {
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	m := NewMetadata()
+	for _, k := range keys {
+		m = m.With(k, pairs[k])
+	}
+	return m
+}
+
+// Map returns the key/value pairs of m. If a key occurs more than once, the
+// last value is used. A trailing key without a value is ignored.
+func (m Metadata) Map() map[string]string {
+	pairs := make(map[string]string, len(m)/2)
+	for i := 0; i+1 < len(m); i += 2 {
+		pairs[m[i]] = m[i+1]
+	}
+	return pairs
+}
+
+// Get returns the last value paired with the given key in m. Returns false if
+// m contains no such key.
+func (m Metadata) Get(key string) (string, bool) {
+	value, ok := "", false
+	for i := 0; i+1 < len(m); i += 2 {
+		if m[i] == key {
+			value, ok = m[i+1], true
+		}
+	}
+	return value, ok
+}
+
+// With returns a copy of m with the given key and value appended. Characters
+// not permitted in a build metadata identifier ([0-9A-Za-z-]) are replaced with
+// "-". The pair is omitted if either key or value is empty.
+func (m Metadata) With(key, value string) Metadata {
+	if "" == key || "" == value {
+		return m
+	}
+	n := make(Metadata, len(m), len(m)+2)
+	copy(n, m)
+	return append(n, identifier(key), identifier(value))
+}
+
+// WithCommit returns a copy of m with the given source revision appended.
+func (m Metadata) WithCommit(commit string) Metadata {
+	return m.With(MetadataCommit, commit)
+}
+
+// WithBuildDate returns a copy of m with the given build date-time appended,
+// formatted in UTC with MetadataDateFormat.
+func (m Metadata) WithBuildDate(t time.Time) Metadata {
+	return m.With(MetadataBuildDate, t.UTC().Format(MetadataDateFormat))
+}
+
+// WithBuilder returns a copy of m with the given builder (e.g., the name of a
+// CI system or host) appended.
+func (m Metadata) WithBuilder(builder string) Metadata {
+	return m.With(MetadataBuilder, builder)
+}
+
+// String returns the build metadata component, without the leading "+".
+func (m Metadata) String() string {
+	return strings.Join(m, ".")
+}
+
+// identifier returns s with each character not permitted in a semantic version
+// identifier replaced with "-".
+func identifier(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r == '-' {
+			return r
+		}
+		return '-'
+	}, s)
+}
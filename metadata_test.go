@@ -0,0 +1,75 @@
+package version_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestMetadata(t *testing.T) {
+	base := version.NewMetadata().WithCommit("abc1234")
+	a := base.WithBuilder("ci/linux")
+	b := base.WithBuilder("local")
+	if got, want := a.String(), "sha.abc1234.builder.ci-linux"; got != want {
+		t.Errorf("a = %q, want %q", got, want)
+	}
+	if got, want := b.String(), "sha.abc1234.builder.local"; got != want {
+		t.Errorf("b = %q, want %q", got, want)
+	}
+	if got := base.With("", "x").With("x", "").String(); got != "sha.abc1234" {
+		t.Errorf("empty pairs = %q, want %q", got, "sha.abc1234")
+	}
+	if "" != version.NewMetadata().String() {
+		t.Errorf("NewMetadata() is not empty")
+	}
+}
+
+func ExampleMetadata() {
+	date := time.Date(2020, 3, 9, 17, 45, 23, 0, time.UTC)
+	v := version.Semver{Major: 1, Minor: 2, Patch: 3}
+	v.Metadata = version.NewMetadata().
+		WithCommit("abc1234").
+		WithBuildDate(date).
+		WithBuilder("ci").
+		String()
+	fmt.Println(v, version.IsValid(v.String()))
+
+	// Output:
+	// 1.2.3+sha.abc1234.date.20200309174523.builder.ci true
+}
+
+func TestParseMetadata(t *testing.T) {
+	for _, s := range []string{"sha.abc123.build", "sha..build.42", "sha.a_b", "1.2.3+sha.ab.x+y"} {
+		if _, err := version.ParseMetadata(s); nil == err {
+			t.Errorf("ParseMetadata(%q) did not return an error", s)
+		}
+	}
+	want := map[string]string{"sha": "abc123", "build": "42"}
+	m, err := version.ParseMetadata(version.MetadataFromMap(want).String())
+	if nil != err {
+		t.Fatal(err)
+	}
+	if got := m.Map(); !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+	for _, s := range []string{"1.2.3", "1.2.3-rc.1"} {
+		if m, err := version.ParseMetadata(s); nil != err || 0 != len(m) {
+			t.Errorf("ParseMetadata(%q) = %v, %v, want empty", s, m, err)
+		}
+	}
+}
+
+func ExampleParseMetadata() {
+	m, err := version.ParseMetadata("1.2.3+sha.abc123.build.42")
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
+	build, _ := m.Get("build")
+	fmt.Println(m.Map()["sha"], build)
+	// Output:
+	// abc123 42
+}
@@ -0,0 +1,37 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// FprintBuildInfo writes to given io.Writer w a constant gauge metric, in the
+// Prometheus text exposition format, labeled with the package version, commit,
+// and Go version used to build the executable. The metric is named
+// "<namespace>_build_info", where namespace defaults to "app" if empty.
+// This allows dashboards to track which versions are deployed.
+// Returns an error if the package version is invalid or if the metric could not
+// be written to w.
+func FprintBuildInfo(w io.Writer, namespace string) error {
+	if "" == namespace {
+		namespace = "app"
+	}
+	name := namespace + "_build_info"
+	ver, err := versionString()
+	if nil != err {
+		return err
+	}
+
+	// escape label values per the exposition format
+	esc := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	_, err = fmt.Fprintf(w, "# HELP %s A constant 1 labeled by the version, "+
+		"commit, and Go version of the build.\n"+
+		"# TYPE %s gauge\n"+
+		"%s{version=\"%s\",commit=\"%s\",goversion=\"%s\"} 1\n",
+		name, name, name, esc.Replace(ver), esc.Replace(Commit),
+		esc.Replace(runtime.Version()))
+	return err
+}
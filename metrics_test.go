@@ -0,0 +1,26 @@
+package version_test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestFprintBuildInfo(t *testing.T) {
+	commit := version.Commit
+	defer func() { version.Commit = commit }()
+	version.Commit = `ab"c`
+
+	var b strings.Builder
+	version.FprintBuildInfo(&b, "")
+	want := `app_build_info{version="` + version.String() +
+		`",commit="ab\"c",goversion="` + runtime.Version() + `"} 1`
+	if !strings.Contains(b.String(), want+"\n") {
+		t.Errorf("missing sample %q in:\n%s", want, b.String())
+	}
+	if !strings.Contains(b.String(), "# TYPE app_build_info gauge\n") {
+		t.Errorf("missing TYPE line in:\n%s", b.String())
+	}
+}
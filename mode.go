@@ -0,0 +1,63 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Mode determines which version strings are accepted by ParseMode, and how they
+// are interpreted. Whichever Mode is used, the String method of the resulting
+// Semver is a strictly valid semantic version, so applications may ingest
+// loosely-formatted versions from external sources while emitting only valid
+// ones.
+type Mode func(version string) (Semver, error)
+
+// Predefined validation modes.
+var (
+	// Strict accepts only valid semantic version strings, as defined by the
+	// Semantic Versioning 2.0.0 specification (see ParseSemver).
+	Strict Mode = ParseSemver
+
+	// Lenient accepts the forms described by ParseLoose, such as " v1.2\n",
+	// in addition to those accepted by Strict.
+	Lenient Mode = func(version string) (Semver, error) {
+		v, _, err := ParseLoose(version)
+		return v, err
+	}
+)
+
+// CustomMode returns a Mode accepting the version strings matched by the given
+// regular expression, which must define capture groups in the same order as
+// VersionPattern: major, minor, and patch, optionally followed by prerelease
+// and build metadata. The Mode returns an error if the captured components do
+// not form a valid semantic version (e.g., a prerelease containing '_').
+// Returns an error if the pattern is invalid or has fewer than three capture
+// groups.
+func CustomMode(pattern string) (Mode, error) {
+	re, err := regexp.Compile(pattern)
+	if nil != err {
+		return nil, err
+	}
+	if re.NumSubexp() < 3 {
+		return nil, fmt.Errorf("custom mode %q: expected at least 3 capture groups", pattern)
+	}
+	return func(version string) (Semver, error) {
+		v, err := parseRegexp(re, version)
+		if nil != err {
+			return Semver{}, err
+		}
+		if _, err := ParseSemver(v.String()); nil != err {
+			return Semver{}, fmt.Errorf("invalid version: %s: %v", version, err)
+		}
+		return v, nil
+	}, nil
+}
+
+// ParseMode parses the given version string using the given Mode, or Strict if
+// mode is nil. The Mode is selected per call, independent of VersionPattern.
+func ParseMode(version string, mode Mode) (Semver, error) {
+	if nil == mode {
+		mode = Strict
+	}
+	return mode(version)
+}
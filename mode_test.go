@@ -0,0 +1,48 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleParseMode() {
+	for _, s := range []string{"1.2.3", " v1.2\n", "release_1_4_0"} {
+		_, serr := version.ParseMode(s, version.Strict)
+		v, lerr := version.ParseMode(s, version.Lenient)
+		fmt.Printf("%q strict=%t lenient=%t %s\n", s, nil == serr, nil == lerr, v)
+	}
+	underscored, err := version.CustomMode(`^release_(\d+)_(\d+)_(\d+)$`)
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
+	v, err := version.ParseMode("release_1_4_0", underscored)
+	fmt.Println(v, err)
+	// Output:
+	// "1.2.3" strict=true lenient=true 1.2.3
+	// " v1.2\n" strict=false lenient=true 1.2.0
+	// "release_1_4_0" strict=false lenient=false 0.0.0
+	// 1.4.0 <nil>
+}
+
+func TestCustomModeInvalid(t *testing.T) {
+	for _, p := range []string{`(\d+)\.(\d+)`, `(`} {
+		if _, err := version.CustomMode(p); nil == err {
+			t.Errorf("CustomMode(%q) did not return an error", p)
+		}
+	}
+	m, err := version.CustomMode(`^(\d+)\.(\d+)\.(\d+)(?:_(\w+))?$`)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if v, err := version.ParseMode("1.2.3_rc1", m); nil != err || "1.2.3-rc1" != v.String() {
+		t.Errorf("ParseMode(1.2.3_rc1) = %s, %v", v, err)
+	}
+	for _, s := range []string{"1.2.3_rc_1", "1.2.3_01", "1.2.99999999999999999999"} {
+		if v, err := version.ParseMode(s, m); nil == err {
+			t.Errorf("ParseMode(%q) = %s, want error", s, v)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// trimMajorSuffix returns a Go module path without its major version suffix
+// (e.g., "example.com/mod" for "example.com/mod/v2"). Suffixes "/v0" and "/v1",
+// which are never valid, are also removed.
+func trimMajorSuffix(path string) string {
+	i := strings.LastIndex(path, "/v")
+	if i < 0 {
+		return path
+	}
+	s := path[i+2:]
+	if _, err := strconv.ParseUint(s, 10, 0); nil != err || (len(s) > 1 && '0' == s[0]) {
+		return path
+	}
+	return path[:i]
+}
+
+// CheckModulePath returns an error if the given version may not be released
+// from a Go module with the given path under the rules of semantic import
+// versioning: versions with major version 0 or 1 require a path without a
+// major version suffix, and versions with major version N ≥ 2 require a path
+// ending in "/vN".
+func CheckModulePath(path, version string) error {
+	v, err := ParseTolerant(version)
+	if nil != err {
+		return err
+	}
+	if want, _ := ModulePath(path, v.String()); want != path {
+		return fmt.Errorf("module %s cannot release version %s: module path must be %s",
+			path, version, want)
+	}
+	return nil
+}
+
+// ModulePath returns the Go module path required to release the given version
+// of a module currently at the given path, adding, replacing, or removing the
+// major version suffix as needed. Release tooling can use it to update go.mod
+// when bumping the major version.
+func ModulePath(path, version string) (string, error) {
+	v, err := ParseTolerant(version)
+	if nil != err {
+		return "", err
+	}
+	prefix := trimMajorSuffix(path)
+	if v.Major < 2 {
+		return prefix, nil
+	}
+	return fmt.Sprintf("%s/v%d", prefix, v.Major), nil
+}
@@ -0,0 +1,40 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCheckModulePath(t *testing.T) {
+	for _, tc := range []struct {
+		path, version string
+		ok            bool
+	}{
+		{"example.com/mod", "v0.3.1", true},
+		{"example.com/mod", "1.2.3", true},
+		{"example.com/mod", "2.0.0", false},
+		{"example.com/mod/v2", "2.0.0-rc.1", true},
+		{"example.com/mod/v2", "1.9.0", false},
+		{"example.com/mod/v2", "3.0.0", false},
+		{"example.com/mod/v1", "1.0.0", false},
+		{"example.com/vendor", "1.0.0", true},
+	} {
+		if err := version.CheckModulePath(tc.path, tc.version); (nil == err) != tc.ok {
+			t.Errorf("CheckModulePath(%q, %q) = %v, want ok=%t", tc.path, tc.version, err, tc.ok)
+		}
+	}
+}
+
+func ExampleModulePath() {
+	for _, v := range []string{"1.4.0", "2.0.0", "3.0.0-rc.1"} {
+		path, _ := version.ModulePath("github.com/ardnew/version/v2", v)
+		fmt.Println(v, path)
+	}
+
+	// Output:
+	// 1.4.0 github.com/ardnew/version
+	// 2.0.0 github.com/ardnew/version/v2
+	// 3.0.0-rc.1 github.com/ardnew/version/v3
+}
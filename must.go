@@ -0,0 +1,33 @@
+package version
+
+// MustParse returns the components of the given version string, validated
+// according to VersionPattern. It is intended for initializing variables from
+// version literals known to be valid.
+// It panics if the version string is invalid.
+func MustParse(version string) Semver {
+	v, err := globalConfig().Parse(version)
+	if nil != err {
+		panic(err)
+	}
+	return v
+}
+
+// MustSet sets the package version using the given version string, like Set.
+// It is intended for init functions, alongside MustParse and MustLoadChangeLog.
+// It panics if the version string is invalid.
+func MustSet(version string) {
+	if err := Default.Set(version); nil != err {
+		panic(err)
+	}
+}
+
+// MustLoadChangeLog returns the entries of the changelog file at the given path,
+// as read by LoadChangeLog.
+// It panics if the file cannot be read or decoded.
+func MustLoadChangeLog(path string) []Change {
+	log, err := LoadChangeLog(path)
+	if nil != err {
+		panic(err)
+	}
+	return log
+}
@@ -0,0 +1,32 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleMustParse() {
+	v := version.MustParse("1.2.3-rc.1")
+	fmt.Println(v.Major, v.Minor, v.Patch, v.Prerelease)
+	// Output:
+	// 1 2 3 rc.1
+}
+
+func TestMustPanics(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"MustParse":         func() { version.MustParse("1.2") },
+		"MustSet":           func() { version.MustSet("1.2") },
+		"MustLoadChangeLog": func() { version.MustLoadChangeLog("testdata/does-not-exist.json") },
+	} {
+		func() {
+			defer func() {
+				if nil == recover() {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
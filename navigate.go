@@ -0,0 +1,54 @@
+package version
+
+// Previous returns the entry in ChangeLog with the highest version precedence
+// lower than the given version, skipping entries marked Yanked. The order of
+// entries in ChangeLog is insignificant, and the given version need not appear
+// in ChangeLog. Returns false if there is no such entry or the given version is
+// invalid.
+// It panics if any of the version strings in ChangeLog are invalid.
+func Previous(version string) (Change, bool) {
+	return ChangeLog.Previous(version)
+}
+
+// Next returns the entry in ChangeLog with the lowest version precedence
+// higher than the given version, skipping entries marked Yanked. The order of
+// entries in ChangeLog is insignificant, and the given version need not appear
+// in ChangeLog. Returns false if there is no such entry or the given version is
+// invalid.
+// It panics if any of the version strings in ChangeLog are invalid.
+func Next(version string) (Change, bool) {
+	return ChangeLog.Next(version)
+}
+
+// Previous returns the entry in History h with the highest version precedence
+// lower than the given version, as described by the package-level Previous.
+func (h History) Previous(version string) (Change, bool) {
+	return h.adjacent(version, -1)
+}
+
+// Next returns the entry in History h with the lowest version precedence
+// higher than the given version, as described by the package-level Next.
+func (h History) Next(version string) (Change, bool) {
+	return h.adjacent(version, 1)
+}
+
+// adjacent returns the entry in History h nearest to the given version in the
+// direction given by the sign of dir.
+func (h History) adjacent(version string, dir int) (Change, bool) {
+	if nil != validate(version) {
+		return Change{}, false
+	}
+	best := -1
+	for i, c := range h {
+		if c.Yanked || compareVersions(c.Version, version)*dir <= 0 {
+			continue
+		}
+		if best < 0 || compareVersions(c.Version, h[best].Version)*dir < 0 {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Change{}, false
+	}
+	return h[best], true
+}
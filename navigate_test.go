@@ -0,0 +1,23 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleHistory_Next() {
+	h := version.History{
+		{Version: "1.1.0"},
+		{Version: "1.0.0"},
+		{Version: "1.2.0-rc.1"},
+		{Version: "1.0.1", Yanked: true},
+		{Version: "1.2.0"},
+	}
+	next, _ := h.Next("1.0.0")
+	prev, _ := h.Previous("1.2.0")
+	_, ok := h.Next("1.2.0")
+	fmt.Println(next.Version, prev.Version, ok)
+	// Output:
+	// 1.1.0 1.2.0-rc.1 false
+}
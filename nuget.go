@@ -0,0 +1,123 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// nugetPattern matches a NuGet version string: one to four numeric components,
+// which may have leading zeros, optionally followed by a prerelease and build
+// metadata as in semantic versions.
+var nugetPattern = regexp.MustCompile(
+	`^(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?` +
+		`(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// NuGetPadding is the number of digits to which ToNuGet pads numeric
+// prerelease identifiers, so that they are ordered correctly by the lexical
+// comparison of SemVer 1.0 (e.g., "beta0002" < "beta0010").
+var NuGetPadding = 4
+
+// nugetVersion contains the components of a NuGet version string.
+type nugetVersion struct {
+	num  [4]uint // major, minor, patch, revision
+	pre  string
+	meta string
+}
+
+// parseNuGet returns the components of the given NuGet version string.
+func parseNuGet(version string) (nugetVersion, error) {
+	sub := nugetPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if nil == sub {
+		return nugetVersion{}, fmt.Errorf("invalid NuGet version: %q", version)
+	}
+	var v nugetVersion
+	for i := range v.num {
+		if "" != sub[i+1] {
+			u, err := strconv.ParseUint(sub[i+1], 10, 0)
+			if nil != err {
+				return nugetVersion{}, fmt.Errorf("invalid NuGet version: %q", version)
+			}
+			v.num[i] = uint(u)
+		}
+	}
+	v.pre, v.meta = sub[5], sub[6]
+	return v, nil
+}
+
+// CompareNuGet returns an integer comparing two NuGet version strings as NuGet
+// does: -1 if a < b, 0 if a == b, and +1 if a > b. The numeric components,
+// including the fourth (revision) component, are compared in turn, and then
+// the prereleases, as in semantic versions but ignoring case. Missing
+// components are zero, and build metadata is ignored.
+// Returns an error if either version string is invalid.
+func CompareNuGet(a, b string) (int, error) {
+	av, err := parseNuGet(a)
+	if nil != err {
+		return 0, err
+	}
+	bv, err := parseNuGet(b)
+	if nil != err {
+		return 0, err
+	}
+	for i := range av.num {
+		if c := compareUint(av.num[i], bv.num[i]); 0 != c {
+			return c, nil
+		}
+	}
+	return comparePrerelease(strings.ToLower(av.pre), strings.ToLower(bv.pre)), nil
+}
+
+// FromNuGet converts a NuGet version string to a semantic version. Missing
+// minor and patch components are zero, and leading zeros are removed. A
+// nonzero fourth (revision) component has no equivalent in semantic versions,
+// so it is recorded in build metadata as "revision.N", which ToNuGet restores.
+// Returns an error if the version is invalid or its prerelease is not a valid
+// semantic version prerelease (e.g., a numeric identifier with leading zeros).
+func FromNuGet(version string) (string, error) {
+	v, err := parseNuGet(version)
+	if nil != err {
+		return "", err
+	}
+	var meta []string
+	if 0 != v.num[3] {
+		meta = append(meta, "revision", strconv.FormatUint(uint64(v.num[3]), 10))
+	}
+	meta = append(meta, SplitIdentifiers(v.meta)...)
+	s := format(v.num[0], v.num[1], v.num[2], v.pre, strings.Join(meta, "."))
+	if _, err := ParseSemver(s); nil != err {
+		return "", fmt.Errorf("NuGet version %q cannot be represented: %v", version, err)
+	}
+	return s, nil
+}
+
+// ToNuGet converts a semantic version string to a NuGet version string that is
+// also valid in SemVer 1.0, for clients that predate SemVer 2.0 support (NuGet
+// 4.3 and older). Since SemVer 1.0 allows a single prerelease identifier,
+// compared lexically, the prerelease identifiers are concatenated with numeric
+// identifiers padded to NuGetPadding digits (e.g., "1.0.0-beta.2" is
+// "1.0.0-beta0002"). A revision recorded in build metadata by FromNuGet is
+// restored as the fourth component; other build metadata is discarded.
+// Returns an error if the version is invalid.
+func ToNuGet(version string) (string, error) {
+	v, err := ParseSemver(version)
+	if nil != err {
+		return "", err
+	}
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if meta := SplitIdentifiers(v.Metadata); len(meta) > 1 && "revision" == meta[0] && isNumeric(meta[1]) {
+		fmt.Fprintf(&b, ".%s", meta[1])
+	}
+	for i, id := range SplitIdentifiers(v.Prerelease) {
+		if 0 == i {
+			b.WriteRune('-')
+		}
+		if n := NuGetPadding - len(id); n > 0 && isNumeric(id) {
+			b.WriteString(strings.Repeat("0", n))
+		}
+		b.WriteString(id)
+	}
+	return b.String(), nil
+}
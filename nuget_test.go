@@ -0,0 +1,55 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestNuGet(t *testing.T) {
+	for _, tc := range []struct {
+		nuget, semver, normal string
+	}{
+		{"1.2.3", "1.2.3", "1.2.3"},
+		{"1.0", "1.0.0", "1.0.0"},
+		{"01.002.3", "1.2.3", "1.2.3"},
+		{"1.2.3.4", "1.2.3+revision.4", "1.2.3.4"},
+		{"1.2.3.0", "1.2.3", "1.2.3"},
+		{"1.0.0-beta.2+sha.5", "1.0.0-beta.2+sha.5", "1.0.0-beta0002"},
+		{"1.0.0-rc.12345", "1.0.0-rc.12345", "1.0.0-rc12345"},
+	} {
+		sv, err := version.FromNuGet(tc.nuget)
+		if nil != err || sv != tc.semver {
+			t.Errorf("FromNuGet(%q) = %q, %v, want %q", tc.nuget, sv, err, tc.semver)
+			continue
+		}
+		n, err := version.ToNuGet(sv)
+		if nil != err || n != tc.normal {
+			t.Errorf("ToNuGet(%q) = %q, %v, want %q", sv, n, err, tc.normal)
+		}
+	}
+	for _, s := range []string{"", "1.2.3.4.5", "v1.2.3", "1.0.0-01", "1.0.0-"} {
+		if _, err := version.FromNuGet(s); nil == err {
+			t.Errorf("FromNuGet(%q): expected error", s)
+		}
+	}
+
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0.0.0", 0},
+		{"1.0.0.1", "1.0.0", 1},
+		{"1.0.0-BETA", "1.0.0-beta", 0},
+		{"1.0.0-beta", "1.0.0", -1},
+		{"1.0.0-beta.10", "1.0.0-beta.2", 1},
+		{"1.0.0+a", "1.0.0+b", 0},
+	} {
+		if c, err := version.CompareNuGet(tc.a, tc.b); nil != err || c != tc.want {
+			t.Errorf("CompareNuGet(%q, %q) = %d, %v; want %d", tc.a, tc.b, c, err, tc.want)
+		}
+	}
+	if _, err := version.CompareNuGet("1.0", "x"); nil == err {
+		t.Error("CompareNuGet(1.0, x): expected error")
+	}
+}
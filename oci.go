@@ -0,0 +1,65 @@
+package version
+
+import (
+	"sort"
+	"time"
+)
+
+// Keys of the pre-defined OCI image annotations written by OCILabels.
+// See https://github.com/opencontainers/image-spec/blob/main/annotations.md.
+const (
+	OCIVersion  = "org.opencontainers.image.version"
+	OCIRevision = "org.opencontainers.image.revision"
+	OCICreated  = "org.opencontainers.image.created"
+)
+
+// OCILabels returns the OCI image labels describing the package version,
+// Commit, and BuildDate (formatted as RFC 3339 where recognized). Labels with
+// empty values are omitted. The result may be encoded as JSON, e.g. for the
+// "labels" of a BuildKit or Compose configuration.
+// Returns an error if any of the version components are invalid.
+func OCILabels() (map[string]string, error) {
+	ver, err := versionString()
+	if nil != err {
+		return nil, err
+	}
+	created := BuildDate
+	if t := ParseDate(BuildDate); nil != t {
+		created = t.UTC().Format(time.RFC3339)
+	}
+	labels := map[string]string{}
+	for key, val := range map[string]string{
+		OCIVersion:  ver,
+		OCIRevision: Commit,
+		OCICreated:  created,
+	} {
+		if "" != val {
+			labels[key] = val
+		}
+	}
+	return labels, nil
+}
+
+// OCILabelArgs returns the labels given by OCILabels as command-line arguments
+// for "docker build" or "buildah bud", e.g.:
+//
+//	--label org.opencontainers.image.version=1.2.3 --label ...
+//
+// Arguments are sorted by label key. Values are not quoted for a shell.
+// Returns an error if any of the version components are invalid.
+func OCILabelArgs() ([]string, error) {
+	labels, err := OCILabels()
+	if nil != err {
+		return nil, err
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		args = append(args, "--label", key+"="+labels[key])
+	}
+	return args, nil
+}
@@ -0,0 +1,28 @@
+package version_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleOCILabelArgs() {
+	defer func(v version.Semver, commit, date string) {
+		version.Version, version.Commit, version.BuildDate = v, commit, date
+	}(version.Version, version.Commit, version.BuildDate)
+	version.Set("1.2.3")
+	version.Commit = "abc1234"
+	version.BuildDate = "Mon, 09 Mar 2020 17:45:23 UTC"
+
+	args, _ := version.OCILabelArgs()
+	fmt.Println(strings.Join(args, "\n"))
+
+	// Output:
+	// --label
+	// org.opencontainers.image.created=2020-03-09T17:45:23Z
+	// --label
+	// org.opencontainers.image.revision=abc1234
+	// --label
+	// org.opencontainers.image.version=1.2.3
+}
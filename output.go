@@ -0,0 +1,75 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// Details contains the version details of the package and executable, as
+// written by FprintPackageVersionFormat.
+type Details struct {
+	Package   string `json:"package,omitempty"`
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	Date      string `json:"date,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+// PackageDetails returns the version details of the package, composed of the
+// package name and version (see FprintPackageVersion), Commit, BuildDate, and
+// the Go version used to build the executable.
+// Returns an error if any of the version components are invalid.
+func PackageDetails() (Details, error) {
+	ver, err := versionString()
+	if nil != err {
+		return Details{}, err
+	}
+	d := Details{
+		Version:   ver,
+		Commit:    Commit,
+		Date:      BuildDate,
+		GoVersion: runtime.Version(),
+	}
+	if c := Default.latest(); nil != c {
+		d.Package = c.Package
+	}
+	return d, nil
+}
+
+// FprintPackageVersionFormat writes to given io.Writer w the version details of
+// the package (see PackageDetails) in the given format, which is one of:
+//
+//	"" or "plain"   the descriptive string written by FprintPackageVersion
+//	"json"          an indented JSON object
+//	other name      the output of the Formatter registered with that name
+//	                (see RegisterFormatter), such as "html"
+//	any other       a template (see NewTemplate) executed with the Details,
+//	                e.g. "{{.Version}} ({{.Commit}})", followed by a newline
+//
+// Returns an error if any of the version components are invalid, the template
+// fails, or the details could not be written to w.
+func FprintPackageVersionFormat(w io.Writer, format string) error {
+	switch format {
+	case "", "plain":
+		return FprintPackageVersion(w)
+	}
+	d, err := PackageDetails()
+	if nil != err {
+		return err
+	}
+	if f, ok := LookupFormatter(format); ok {
+		return f.FormatVersion(w, d)
+	}
+	t, err := NewTemplate("format", format)
+	if nil != err {
+		return err
+	}
+	b := strings.Builder{}
+	if err := t.Execute(&b, d); nil != err {
+		return err
+	}
+	_, err = fmt.Fprintln(w, b.String())
+	return err
+}
@@ -0,0 +1,31 @@
+package version_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestFprintPackageVersionFormat(t *testing.T) {
+	defer func(commit string) { version.Commit = commit }(version.Commit)
+	version.Commit = "abc1234"
+	for format, want := range map[string]string{
+		"":                                      "mypkg version ",
+		"json":                                  `"commit": "abc1234"`,
+		"{{.Package}}@{{.Commit}}":              "mypkg@abc1234\n",
+		`{{json .Commit}}`:                      `"abc1234"` + "\n",
+		"{{.Version | semver | printf \"%T\"}}": "version.Semver\n",
+	} {
+		var b strings.Builder
+		if err := version.FprintPackageVersionFormat(&b, format); nil != err {
+			t.Errorf("FprintPackageVersionFormat(%q) = %v", format, err)
+		} else if !strings.Contains(b.String(), want) {
+			t.Errorf("FprintPackageVersionFormat(%q) wrote %q, want %q", format, b.String(), want)
+		}
+	}
+	if err := version.FprintPackageVersionFormat(os.Stdout, "{{.Bogus}"); nil == err {
+		t.Errorf("FprintPackageVersionFormat(invalid template) = nil error")
+	}
+}
@@ -0,0 +1,111 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseError describes why a semantic version string is invalid, identifying
+// the malformed component and the offset at which the problem was found.
+type ParseError struct {
+	Version   string // the invalid version string
+	Component string // "major", "minor", "patch", "prerelease", or "metadata"
+	Offset    int    // byte offset into Version (the reported position is Offset+1)
+	Reason    string // e.g., "leading zero", "missing", "invalid character 'x'"
+}
+
+// Error returns a description of ParseError e, such as:
+//
+//	invalid version "1.02.3": leading zero in minor component at position 3
+func (e *ParseError) Error() string {
+	what := e.Reason + " in " + e.Component + " component"
+	if "missing" == e.Reason {
+		what = "missing " + e.Component + " component"
+	}
+	return fmt.Sprintf("invalid version %q: %s at position %d",
+		e.Version, what, e.Offset+1)
+}
+
+// diagnose returns a ParseError describing why the given semantic version string
+// is invalid according to the default VersionPattern. It is only called after
+// scanSemver has failed, so the scanner's fast path remains allocation-free.
+func diagnose(s string) *ParseError {
+	fail := func(comp string, i int, reason string) *ParseError {
+		return &ParseError{Version: s, Component: comp, Offset: i, Reason: reason}
+	}
+	unexpected := func(i int) string {
+		return fmt.Sprintf("invalid character %q", s[i])
+	}
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+
+	// major.minor.patch
+	i := 0
+	comps := []string{"major", "minor", "patch"}
+	for n, comp := range comps {
+		if n > 0 {
+			if i >= len(s) {
+				return fail(comp, i, "missing")
+			}
+			if '.' != s[i] {
+				return fail(comps[n-1], i, unexpected(i))
+			}
+			i++
+		}
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		switch {
+		case i == start && i < len(s) && '.' != s[i]:
+			return fail(comp, i, unexpected(i))
+		case i == start:
+			return fail(comp, i, "missing")
+		case i-start > 1 && '0' == s[start]:
+			return fail(comp, start, "leading zero")
+		}
+		if _, err := strconv.ParseUint(s[start:i], 10, 0); nil != err {
+			return fail(comp, start, "overflow")
+		}
+	}
+
+	// -prerelease+metadata
+	for _, sec := range []struct {
+		comp       string
+		sep        byte
+		prerelease bool
+	}{{"prerelease", '-', true}, {"metadata", '+', false}} {
+		if i >= len(s) || sec.sep != s[i] {
+			continue
+		}
+		for {
+			i++ // skip separator or '.'
+			start, numeric := i, true
+			for ; i < len(s) && '.' != s[i] && '+' != s[i]; i++ {
+				switch c := s[i]; {
+				case isDigit(c):
+				case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', '-' == c:
+					numeric = false
+				default:
+					return fail(sec.comp, i, unexpected(i))
+				}
+			}
+			if i == start {
+				return fail(sec.comp, i, "empty identifier")
+			}
+			if sec.prerelease && numeric && i-start > 1 && '0' == s[start] {
+				return fail(sec.comp, start, "leading zero")
+			}
+			if i >= len(s) || '.' != s[i] {
+				break
+			}
+		}
+	}
+	if i < len(s) {
+		comp := "patch"
+		if '+' == s[i] {
+			comp = "metadata"
+		}
+		return fail(comp, i, unexpected(i))
+	}
+	return fail("", 0, "unknown error")
+}
@@ -0,0 +1,203 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pep440Pattern matches the permitted (non-normalized) forms of a PEP 440
+// version string.
+//
+// Source: https://peps.python.org/pep-0440/#appendix-b-parsing-version-strings-with-regular-expressions
+var pep440Pattern = regexp.MustCompile(`^(?i)v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_.]?(?P<pre_l>a|b|c|rc|alpha|beta|pre|preview)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+// pep440Label maps each spelling of a PEP 440 pre-release label to its
+// normalized form.
+var pep440Label = map[string]string{
+	"a": "a", "alpha": "a",
+	"b": "b", "beta": "b",
+	"c": "rc", "rc": "rc", "pre": "rc", "preview": "rc",
+}
+
+// semverLabel maps each normalized PEP 440 pre-release label to the prerelease
+// identifier used in semantic versions.
+var semverLabel = map[string]string{"a": "alpha", "b": "beta", "rc": "rc"}
+
+// FromPEP440 converts a Python PEP 440 version string to a semantic version.
+//
+// The release segment is padded with zeros to three components, pre-releases
+// (aN, bN, rcN) become prerelease identifiers "alpha.N", "beta.N", and "rc.N",
+// and development releases (.devN) append prerelease identifiers "dev.N".
+// The epoch (N!), post-release (.postN), and local version (+local) have no
+// equivalent in semantic versions, so they are recorded in build metadata as
+// "epoch.N", "post.N", and the local identifiers, respectively. ToPEP440 restores
+// them, but note that build metadata does not affect semantic version precedence.
+//
+// Returns an error if the version is invalid, its release segment has more
+// than three components, or a number is out of range.
+func FromPEP440(version string) (string, error) {
+	sub := pep440Pattern.FindStringSubmatch(strings.TrimSpace(version))
+	if nil == sub {
+		return "", fmt.Errorf("invalid PEP 440 version: %q", version)
+	}
+	group := func(name string) string {
+		for i, n := range pep440Pattern.SubexpNames() {
+			if n == name {
+				return strings.ToLower(sub[i])
+			}
+		}
+		return ""
+	}
+	var err error // first invalid number
+	number := func(s string) string {
+		if "" == s {
+			return "0" // missing number implies 0
+		}
+		n, e := strconv.ParseUint(s, 10, 0)
+		if nil != e && nil == err {
+			err = fmt.Errorf("invalid PEP 440 version %q: %v", version, e)
+		}
+		return strconv.FormatUint(n, 10)
+	}
+
+	rel := strings.Split(group("release"), ".")
+	if len(rel) > 3 {
+		return "", fmt.Errorf("PEP 440 version %q has more than 3 release components", version)
+	}
+	for len(rel) < 3 {
+		rel = append(rel, "0")
+	}
+	for i := range rel {
+		rel[i] = number(rel[i])
+	}
+
+	var pre, meta []string
+	if "" != group("pre") {
+		pre = append(pre, semverLabel[pep440Label[group("pre_l")]], number(group("pre_n")))
+	}
+	if "" != group("dev") {
+		pre = append(pre, "dev", number(group("dev_n")))
+	}
+	if e := group("epoch"); "" != e && "0" != number(e) {
+		meta = append(meta, "epoch", number(e))
+	}
+	if "" != group("post") {
+		meta = append(meta, "post", number(group("post_n1")+group("post_n2")))
+	}
+	if l := group("local"); "" != l {
+		meta = append(meta, strings.FieldsFunc(l, func(c rune) bool {
+			return '-' == c || '_' == c || '.' == c
+		})...)
+	}
+
+	if nil != err {
+		return "", err
+	}
+	major, _ := strconv.ParseUint(rel[0], 10, 0)
+	minor, _ := strconv.ParseUint(rel[1], 10, 0)
+	patch, _ := strconv.ParseUint(rel[2], 10, 0)
+	return format(uint(major), uint(minor), uint(patch),
+		strings.Join(pre, "."), strings.Join(meta, ".")), nil
+}
+
+// ToPEP440 converts a semantic version string to a normalized Python PEP 440
+// version string. It is the inverse of FromPEP440.
+//
+// Prerelease identifiers may contain at most one pre-release label ("alpha",
+// "a", "beta", "b", "rc", "c", "pre", or "preview"), post-release label
+// ("post"), and development label ("dev"), in that order, each optionally
+// followed by a number either attached ("rc1") or as the next identifier
+// ("rc.1"). Build metadata is converted to the local version, except for any
+// leading "epoch.N" and "post.N" identifiers produced by FromPEP440.
+//
+// Returns an error if the version is invalid or its prerelease cannot be
+// represented in PEP 440.
+func ToPEP440(version string) (string, error) {
+	if !IsValid(version) {
+		return "", fmt.Errorf("invalid version: %q", version)
+	}
+	major, minor, patch, pre, meta := Parse(version)
+
+	var epoch, post, dev string
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "%d.%d.%d", major, minor, patch)
+
+	// parse labeled prerelease identifiers
+	rank := 0 // 1=pre, 2=post, 3=dev; labels must appear in increasing rank
+	ids := strings.Split(pre, ".")
+	for i := 0; "" != pre && i < len(ids); i++ {
+		id := strings.ToLower(ids[i])
+		label := strings.TrimRight(id, "0123456789")
+		num := id[len(label):]
+		if "" == num && i+1 < len(ids) && isNumeric(ids[i+1]) {
+			num = ids[i+1]
+			i++
+		}
+		if "" == num {
+			num = "0"
+		}
+		r := 0
+		switch {
+		case "" != pep440Label[label]:
+			r = 1
+			fmt.Fprintf(&b, "%s%s", pep440Label[label], num)
+		case "post" == label:
+			r, post = 2, num
+		case "dev" == label:
+			r, dev = 3, num
+		}
+		if r <= rank {
+			return "", fmt.Errorf("prerelease %q cannot be represented in PEP 440", pre)
+		}
+		rank = r
+	}
+
+	// extract epoch and post-release recorded in build metadata
+	ids = strings.Split(meta, ".")
+	for len(ids) > 1 && isNumeric(ids[1]) {
+		if "epoch" == ids[0] && "" == epoch && "" == post {
+			epoch = ids[1]
+		} else if "post" == ids[0] && "" == post {
+			post = ids[1]
+		} else {
+			break
+		}
+		ids = ids[2:]
+	}
+
+	if "" != post {
+		fmt.Fprintf(&b, ".post%s", post)
+	}
+	if "" != dev {
+		fmt.Fprintf(&b, ".dev%s", dev)
+	}
+	if local := strings.Join(ids, "."); "" != local {
+		b.WriteRune('+')
+		b.WriteString(strings.ToLower(strings.Replace(local, "-", ".", -1)))
+	}
+	if "" != epoch {
+		return epoch + "!" + b.String(), nil
+	}
+	return b.String(), nil
+}
+
+// isNumeric returns true if and only if s is a non-empty string of digits.
+func isNumeric(s string) bool {
+	if "" == s {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
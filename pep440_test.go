@@ -0,0 +1,49 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestPEP440(t *testing.T) {
+	for _, tc := range []struct {
+		pep, semver, normal string
+	}{
+		{"1.2.3", "1.2.3", "1.2.3"},
+		{"v1.2", "1.2.0", "1.2.0"},
+		{"1.0.0a1", "1.0.0-alpha.1", "1.0.0a1"},
+		{"1.0.0-Alpha", "1.0.0-alpha.0", "1.0.0a0"},
+		{"1.0.0.beta.2", "1.0.0-beta.2", "1.0.0b2"},
+		{"1.0c3", "1.0.0-rc.3", "1.0.0rc3"},
+		{"1.0.0rc1.dev2", "1.0.0-rc.1.dev.2", "1.0.0rc1.dev2"},
+		{"1.0.0.dev", "1.0.0-dev.0", "1.0.0.dev0"},
+		{"1.0.0-1", "1.0.0+post.1", "1.0.0.post1"},
+		{"1.0.0.post1.dev3", "1.0.0-dev.3+post.1", "1.0.0.post1.dev3"},
+		{"2!1.0.0rev4+ubuntu-1", "1.0.0+epoch.2.post.4.ubuntu.1", "2!1.0.0.post4+ubuntu.1"},
+		{"0!01.02.03", "1.2.3", "1.2.3"},
+	} {
+		sv, err := version.FromPEP440(tc.pep)
+		if nil != err || sv != tc.semver {
+			t.Errorf("FromPEP440(%q) = %q, %v, want %q", tc.pep, sv, err, tc.semver)
+			continue
+		}
+		pep, err := version.ToPEP440(sv)
+		if nil != err || pep != tc.normal {
+			t.Errorf("ToPEP440(%q) = %q, %v, want %q", sv, pep, err, tc.normal)
+		}
+	}
+	for _, s := range []string{
+		"1.2.3.4", "1.0.0.dev1.post1", "foo",
+		"1.99999999999999999999", "1.0rc99999999999999999999",
+	} {
+		if _, err := version.FromPEP440(s); nil == err {
+			t.Errorf("FromPEP440(%q) expected error", s)
+		}
+	}
+	for _, s := range []string{"1.0.0-foo", "1.0.0-dev.1.rc.1", "1.0.0-rc.1.rc.2", "1.0"} {
+		if _, err := version.ToPEP440(s); nil == err {
+			t.Errorf("ToPEP440(%q) expected error", s)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package version
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Project describes the version metadata of a project, as loaded by
+// LoadProject from a small file shared with non-Go assets (documentation,
+// packaging scripts, etc.), such as ".version.yaml":
+//
+//	package: mypkg
+//	version: 1.2.3
+//	changelog: CHANGELOG.md
+//
+// or "version.json":
+//
+//	{"package": "mypkg", "version": "1.2.3", "changelog": "CHANGELOG.md"}
+type Project struct {
+	Package   string `json:"package,omitempty"`
+	Version   string `json:"version,omitempty"`
+	ChangeLog string `json:"changelog,omitempty"` // path relative to the project file
+}
+
+// LoadProject reads the project file at the given path, decoded as YAML if its
+// name ends with ".yaml" or ".yml", and as JSON otherwise. Only a flat mapping
+// of the keys "package", "version", and "changelog" to scalars is supported in
+// YAML. A relative ChangeLog path is resolved relative to the directory
+// containing the project file.
+// Returns an error if the file cannot be read or decoded, or if the version is
+// invalid.
+func LoadProject(path string) (*Project, error) {
+	data, err := ioutil.ReadFile(path)
+	if nil != err {
+		return nil, err
+	}
+	var p Project
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = p.decodeYAML(string(data))
+	default:
+		err = json.Unmarshal(data, &p)
+	}
+	if nil != err {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if "" != p.Version {
+		if err := validate(p.Version); nil != err {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+	if "" != p.ChangeLog && !filepath.IsAbs(p.ChangeLog) {
+		p.ChangeLog = filepath.Join(filepath.Dir(path), p.ChangeLog)
+	}
+	return &p, nil
+}
+
+// decodeYAML decodes into Project p a flat YAML mapping of keys to scalars.
+func (p *Project) decodeYAML(text string) error {
+	s := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if "" == line || strings.HasPrefix(line, "#") || "---" == line {
+			continue
+		}
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			return fmt.Errorf("line %d: expected \"key: value\"", n)
+		}
+		key, val := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		if len(val) > 1 && ('"' == val[0] || '\'' == val[0]) && val[0] == val[len(val)-1] {
+			if '"' == val[0] {
+				uq, err := strconv.Unquote(val)
+				if nil != err {
+					return fmt.Errorf("line %d: %v", n, err)
+				}
+				val = uq
+			} else {
+				val = strings.Replace(val[1:len(val)-1], "''", "'", -1)
+			}
+		}
+		switch key {
+		case "package":
+			p.Package = val
+		case "version":
+			p.Version = val
+		case "changelog":
+			p.ChangeLog = val
+		default:
+			return fmt.Errorf("line %d: unknown key %q", n, key)
+		}
+	}
+	return s.Err()
+}
+
+// Apply loads the ChangeLog file of Project p (if any) into ChangeLog, setting
+// the Package of each entry that has none, and sets the package version to
+// p.Version (if any) with Set.
+// Returns an error if the changelog cannot be loaded.
+func (p *Project) Apply() error {
+	if "" != p.ChangeLog {
+		log, err := LoadChangeLog(p.ChangeLog)
+		if nil != err {
+			return err
+		}
+		for i := range log {
+			if "" == log[i].Package {
+				log[i].Package = p.Package
+			}
+		}
+		ChangeLog = log
+	}
+	if "" != p.Version {
+		if err := validate(p.Version); nil != err {
+			return err
+		}
+		Set(p.Version)
+	}
+	return nil
+}
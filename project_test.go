@@ -0,0 +1,64 @@
+package version_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestLoadProject(t *testing.T) {
+	defer func(log []version.Change, v version.Semver) {
+		version.ChangeLog, version.Version = log, v
+	}(version.ChangeLog, version.Version)
+
+	dir, err := ioutil.TempDir("", "project")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name, text string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(text), 0644); nil != err {
+			t.Fatal(err)
+		}
+		return path
+	}
+	write("CHANGELOG.md", "## [1.2.0] - 2020-03-09\n- first\n")
+	yaml := write(".version.yaml", "# project metadata\npackage: mypkg\nversion: \"1.2.0\" # quoted\nchangelog: CHANGELOG.md\n")
+	json := write("version.json", `{"package": "mypkg", "version": "1.2.0", "changelog": "CHANGELOG.md"}`)
+
+	for _, path := range []string{yaml, json} {
+		p, err := version.LoadProject(path)
+		if nil != err {
+			t.Fatalf("LoadProject(%s) = %v", path, err)
+		}
+		want := version.Project{
+			Package:   "mypkg",
+			Version:   "1.2.0",
+			ChangeLog: filepath.Join(dir, "CHANGELOG.md"),
+		}
+		if *p != want {
+			t.Errorf("LoadProject(%s) = %+v, want %+v", path, *p, want)
+		}
+		version.ChangeLog, version.Version = nil, version.Semver{}
+		if err := p.Apply(); nil != err {
+			t.Fatalf("Apply() = %v", err)
+		}
+		if "1.2.0" != version.String() || 1 != len(version.ChangeLog) ||
+			"mypkg" != version.ChangeLog[0].Package {
+			t.Errorf("Apply() set %q, %+v", version.String(), version.ChangeLog)
+		}
+	}
+
+	bad := write("bad.yml", "package: mypkg\nname: other\n")
+	if _, err := version.LoadProject(bad); nil == err {
+		t.Errorf("LoadProject(unknown key) = nil error")
+	}
+	bad = write("bad.json", `{"version": "1.2"}`)
+	if _, err := version.LoadProject(bad); nil == err {
+		t.Errorf("LoadProject(invalid version) = nil error")
+	}
+}
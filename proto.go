@@ -0,0 +1,308 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The messages defined in proto/version.proto are encoded in the protocol
+// buffer wire format by the methods below, without depending on a protobuf
+// runtime. Services may return the encoded bytes directly, or decode them into
+// types generated from the same file, e.g. with proto.Unmarshal.
+
+// protoBuffer encodes the fields of a protocol buffer message. Fields with
+// zero values are omitted, as in proto3.
+type protoBuffer []byte
+
+func (p *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		*p = append(*p, byte(x)|0x80)
+		x >>= 7
+	}
+	*p = append(*p, byte(x))
+}
+
+func (p *protoBuffer) uint(field int, x uint64) {
+	if 0 != x {
+		p.varint(uint64(field) << 3)
+		p.varint(x)
+	}
+}
+
+func (p *protoBuffer) bool(field int, b bool) {
+	if b {
+		p.uint(field, 1)
+	}
+}
+
+func (p *protoBuffer) bytes(field int, b []byte) {
+	p.varint(uint64(field)<<3 | 2)
+	p.varint(uint64(len(b)))
+	*p = append(*p, b...)
+}
+
+func (p *protoBuffer) string(field int, s string) {
+	if "" != s {
+		p.bytes(field, []byte(s))
+	}
+}
+
+func (p *protoBuffer) strings(field int, list []string) {
+	for _, s := range list {
+		p.bytes(field, []byte(s)) // repeated elements are never omitted
+	}
+}
+
+// errProtoTruncated is returned when decoding a truncated message.
+var errProtoTruncated = errors.New("proto: truncated message")
+
+// protoVarint decodes the varint at the beginning of b, and returns it with
+// the number of bytes it occupies.
+func protoVarint(b []byte) (uint64, int, error) {
+	var x uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		x |= uint64(b[i]&0x7f) << (7 * uint(i))
+		if b[i] < 0x80 {
+			return x, i + 1, nil
+		}
+	}
+	return 0, 0, errProtoTruncated
+}
+
+// protoFields calls fn with each field of the encoded message b: its number,
+// and either its varint value or its length-delimited data. Fields of other
+// wire types are skipped.
+func protoFields(b []byte, fn func(field int, x uint64, data []byte) error) error {
+	for len(b) > 0 {
+		tag, n, err := protoVarint(b)
+		if nil != err {
+			return err
+		}
+		b = b[n:]
+		field, wire := int(tag>>3), tag&7
+		var x uint64
+		var data []byte
+		switch wire {
+		case 0: // varint
+			if x, n, err = protoVarint(b); nil != err {
+				return err
+			}
+		case 1: // 64-bit
+			n = 8
+		case 2: // length-delimited
+			if x, n, err = protoVarint(b); nil != err {
+				return err
+			}
+			if uint64(len(b)-n) < x {
+				return errProtoTruncated
+			}
+			data, n = b[n:n+int(x)], n+int(x)
+		case 5: // 32-bit
+			n = 4
+		default:
+			return fmt.Errorf("proto: unsupported wire type %d", wire)
+		}
+		if len(b) < n {
+			return errProtoTruncated
+		}
+		b = b[n:]
+		if 1 == wire || 5 == wire {
+			continue
+		}
+		if err := fn(field, x, data); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalProto returns Semver v encoded as the Semver message of
+// proto/version.proto.
+func (v Semver) MarshalProto() []byte {
+	var p protoBuffer
+	p.uint(1, uint64(v.Major))
+	p.uint(2, uint64(v.Minor))
+	p.uint(3, uint64(v.Patch))
+	p.string(4, v.Prerelease)
+	p.string(5, v.Metadata)
+	return p
+}
+
+// UnmarshalSemverProto decodes a Semver message of proto/version.proto.
+// Returns an error if the message is malformed.
+func UnmarshalSemverProto(b []byte) (Semver, error) {
+	var v Semver
+	err := protoFields(b, func(field int, x uint64, data []byte) error {
+		switch field {
+		case 1:
+			v.Major = uint(x)
+		case 2:
+			v.Minor = uint(x)
+		case 3:
+			v.Patch = uint(x)
+		case 4:
+			v.Prerelease = string(data)
+		case 5:
+			v.Metadata = string(data)
+		}
+		return nil
+	})
+	return v, err
+}
+
+// MarshalProto returns Change c encoded as the Change message of
+// proto/version.proto.
+func (c *Change) MarshalProto() []byte {
+	var p protoBuffer
+	p.string(1, c.Package)
+	p.string(2, c.Module)
+	p.string(3, c.Version)
+	p.string(4, c.Title)
+	p.string(5, c.Date)
+	p.strings(6, c.Description)
+	p.bool(7, c.Breaking)
+	p.strings(8, c.Authors)
+	p.strings(9, c.Links)
+	p.bool(10, c.Yanked)
+	for _, a := range c.Artifacts {
+		var m protoBuffer
+		m.string(1, a.Name)
+		m.string(2, a.Platform)
+		m.string(3, a.SHA256)
+		m.string(4, a.URL)
+		p.bytes(11, m)
+	}
+	for _, d := range c.Deprecations {
+		var m protoBuffer
+		m.string(1, d.Feature)
+		m.string(2, d.Removal)
+		p.bytes(12, m)
+	}
+	p.string(13, c.Template)
+	return p
+}
+
+// UnmarshalChangeProto decodes a Change message of proto/version.proto.
+// Returns an error if the message is malformed.
+func UnmarshalChangeProto(b []byte) (Change, error) {
+	var c Change
+	err := protoFields(b, func(field int, x uint64, data []byte) error {
+		switch field {
+		case 1:
+			c.Package = string(data)
+		case 2:
+			c.Module = string(data)
+		case 3:
+			c.Version = string(data)
+		case 4:
+			c.Title = string(data)
+		case 5:
+			c.Date = string(data)
+		case 6:
+			c.Description = append(c.Description, string(data))
+		case 7:
+			c.Breaking = 0 != x
+		case 8:
+			c.Authors = append(c.Authors, string(data))
+		case 9:
+			c.Links = append(c.Links, string(data))
+		case 10:
+			c.Yanked = 0 != x
+		case 11:
+			var a Artifact
+			c.Artifacts = append(c.Artifacts, a)
+			return protoFields(data, func(field int, _ uint64, data []byte) error {
+				a := &c.Artifacts[len(c.Artifacts)-1]
+				switch field {
+				case 1:
+					a.Name = string(data)
+				case 2:
+					a.Platform = string(data)
+				case 3:
+					a.SHA256 = string(data)
+				case 4:
+					a.URL = string(data)
+				}
+				return nil
+			})
+		case 12:
+			var d Deprecation
+			c.Deprecations = append(c.Deprecations, d)
+			return protoFields(data, func(field int, _ uint64, data []byte) error {
+				d := &c.Deprecations[len(c.Deprecations)-1]
+				switch field {
+				case 1:
+					d.Feature = string(data)
+				case 2:
+					d.Removal = string(data)
+				}
+				return nil
+			})
+		case 13:
+			c.Template = string(data)
+		}
+		return nil
+	})
+	return c, err
+}
+
+// MarshalChangeLogProto returns the given entries encoded as the ChangeLog
+// message of proto/version.proto.
+func MarshalChangeLogProto(log []Change) []byte {
+	var p protoBuffer
+	for i := range log {
+		p.bytes(1, log[i].MarshalProto())
+	}
+	return p
+}
+
+// UnmarshalChangeLogProto decodes a ChangeLog message of proto/version.proto.
+// Returns an error if the message is malformed.
+func UnmarshalChangeLogProto(b []byte) ([]Change, error) {
+	var log []Change
+	err := protoFields(b, func(field int, _ uint64, data []byte) error {
+		if 1 == field {
+			c, err := UnmarshalChangeProto(data)
+			if nil != err {
+				return err
+			}
+			log = append(log, c)
+		}
+		return nil
+	})
+	return log, err
+}
+
+// MarshalProto returns Details d encoded as the Details message of
+// proto/version.proto.
+func (d Details) MarshalProto() []byte {
+	var p protoBuffer
+	p.string(1, d.Package)
+	p.string(2, d.Version)
+	p.string(3, d.Commit)
+	p.string(4, d.Date)
+	p.string(5, d.GoVersion)
+	return p
+}
+
+// UnmarshalDetailsProto decodes a Details message of proto/version.proto.
+// Returns an error if the message is malformed.
+func UnmarshalDetailsProto(b []byte) (Details, error) {
+	var d Details
+	err := protoFields(b, func(field int, _ uint64, data []byte) error {
+		switch field {
+		case 1:
+			d.Package = string(data)
+		case 2:
+			d.Version = string(data)
+		case 3:
+			d.Commit = string(data)
+		case 4:
+			d.Date = string(data)
+		case 5:
+			d.GoVersion = string(data)
+		}
+		return nil
+	})
+	return d, err
+}
@@ -0,0 +1,60 @@
+package version_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestSemverProto(t *testing.T) {
+	v := version.Semver{Major: 1, Minor: 2, Patch: 300, Prerelease: "rc.1"}
+	b := v.MarshalProto()
+	want := []byte{0x08, 1, 0x10, 2, 0x18, 0xac, 0x02, 0x22, 4, 'r', 'c', '.', '1'}
+	if !bytes.Equal(b, want) {
+		t.Fatalf("MarshalProto() = % x, want % x", b, want)
+	}
+	got, err := version.UnmarshalSemverProto(b)
+	if nil != err || got != v {
+		t.Fatalf("UnmarshalSemverProto() = %v, %v; want %v", got, err, v)
+	}
+	if _, err := version.UnmarshalSemverProto(b[:len(b)-1]); nil == err {
+		t.Error("UnmarshalSemverProto(truncated): expected error")
+	}
+}
+
+func TestChangeLogProto(t *testing.T) {
+	log := []version.Change{
+		{Version: "0.1.0", Date: "2020-02-26", Description: []string{"initial", ""}},
+		{
+			Module: "cli", Version: "0.2.0", Title: "Red", Breaking: true,
+			Authors:      []string{"a", "b"},
+			Artifacts:    []version.Artifact{{Name: "x.tgz", Platform: "linux/amd64", URL: "u"}},
+			Deprecations: []version.Deprecation{{Feature: "-old", Removal: "1.0.0"}},
+			Template:     "breaking",
+		},
+	}
+	got, err := version.UnmarshalChangeLogProto(version.MarshalChangeLogProto(log))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, log) {
+		t.Errorf("round trip = %+v, want %+v", got, log)
+	}
+
+	// unknown fields of every wire type are skipped
+	c := log[0]
+	b := append(c.MarshalProto(), 0x78, 1, 0x81, 1, 1, 2, 3, 4, 5, 6, 7, 8, 0x85, 1, 1, 2, 3, 4)
+	if u, err := version.UnmarshalChangeProto(b); nil != err || !reflect.DeepEqual(u, c) {
+		t.Errorf("UnmarshalChangeProto(unknown fields) = %+v, %v", u, err)
+	}
+}
+
+func TestDetailsProto(t *testing.T) {
+	d := version.Details{Package: "p", Version: "1.0.0", GoVersion: "go1.14"}
+	got, err := version.UnmarshalDetailsProto(d.MarshalProto())
+	if nil != err || got != d {
+		t.Errorf("round trip = %+v, %v; want %+v", got, err, d)
+	}
+}
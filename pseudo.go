@@ -0,0 +1,82 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// pseudoPattern matches Go module pseudo-versions, with optional "v" prefix.
+//
+// Source: golang.org/x/mod/module
+var pseudoPattern = regexp.MustCompile(`^v?[0-9]+\.(0\.0-|\d+\.\d+-([^+]*\.)?0\.)\d{14}-[A-Za-z0-9]+(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// PseudoVersion represents the components of a Go module pseudo-version, such
+// as "v0.0.0-20230101120000-abcdef123456", which identifies a revision that
+// has no semantic version tag.
+type PseudoVersion struct {
+	// Version is the complete pseudo-version without "v" prefix, a valid
+	// semantic version that can be compared with tagged versions.
+	Version string
+
+	// Base is the tagged version (without "v" prefix) from which the revision
+	// is derived, or an empty string if no such version exists.
+	Base string
+
+	// Time is the UTC commit time of the revision.
+	Time time.Time
+
+	// Revision is the abbreviated commit hash of the revision.
+	Revision string
+}
+
+// IsPseudoVersion returns true if and only if the given version string is a Go
+// module pseudo-version, with or without "v" prefix.
+func IsPseudoVersion(version string) bool {
+	return strings.Count(version, "-") >= 2 &&
+		IsValid(strings.TrimPrefix(version, "v")) &&
+		pseudoPattern.MatchString(version)
+}
+
+// ParsePseudoVersion decomposes a Go module pseudo-version, with or without
+// "v" prefix, into its base version, commit time, and revision.
+func ParsePseudoVersion(version string) (PseudoVersion, error) {
+	if !IsPseudoVersion(version) {
+		return PseudoVersion{}, fmt.Errorf("invalid pseudo-version: %s", version)
+	}
+	ver := strings.TrimPrefix(version, "v")
+	major, minor, patch, pre, meta := Parse(ver)
+
+	// the prerelease ends with "TIMESTAMP-REVISION", preceded by either
+	// nothing ("vX.0.0-"), "0." ("vX.Y.Z-0."), or "PRE.0." ("vX.Y.Z-PRE.0.")
+	i := strings.LastIndexByte(pre, '-')
+	rev, ts, pre := pre[i+1:], pre[i-14:i], pre[:i-14]
+	t, err := time.Parse("20060102150405", ts)
+	if nil != err {
+		return PseudoVersion{}, fmt.Errorf("invalid pseudo-version: %s: %v", version, err)
+	}
+
+	p := PseudoVersion{Version: ver, Time: t, Revision: rev}
+	switch {
+	case "" == pre:
+		// no base version
+	case "0." == pre:
+		if 0 == patch {
+			return PseudoVersion{}, fmt.Errorf("invalid pseudo-version: %s: patch is 0", version)
+		}
+		p.Base = format(major, minor, patch-1, "", meta)
+	default:
+		p.Base = format(major, minor, patch, strings.TrimSuffix(pre, ".0."), meta)
+	}
+	return p, nil
+}
+
+// Compare returns -1, 0, or +1 if pseudo-version p has lower, equal, or higher
+// precedence than the given version string (with or without "v" prefix),
+// respectively. A pseudo-version has higher precedence than its base version
+// and lower precedence than any later tagged version.
+// It panics if the given version string is invalid.
+func (p PseudoVersion) Compare(version string) int {
+	return Compare(p.Version, strings.TrimPrefix(version, "v"))
+}
@@ -0,0 +1,46 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestParsePseudoVersion(t *testing.T) {
+	ts := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		in, base string
+	}{
+		{"v0.0.0-20230101120000-abcdef123456", ""},
+		{"v1.2.4-0.20230101120000-abcdef123456", "1.2.3"},
+		{"1.2.3-rc.1.0.20230101120000-abcdef123456", "1.2.3-rc.1"},
+		{"v2.0.1-0.20230101120000-abcdef123456+incompatible", "2.0.0+incompatible"},
+	} {
+		p, err := version.ParsePseudoVersion(tc.in)
+		if nil != err {
+			t.Errorf("ParsePseudoVersion(%q): %v", tc.in, err)
+			continue
+		}
+		if p.Base != tc.base || !p.Time.Equal(ts) || "abcdef123456" != p.Revision {
+			t.Errorf("ParsePseudoVersion(%q) = %+v, want base %q", tc.in, p, tc.base)
+		}
+		if "" != p.Base && p.Compare(p.Base) <= 0 {
+			t.Errorf("%q does not follow its base %q", tc.in, p.Base)
+		}
+	}
+	for _, s := range []string{"v1.2.3", "v1.2.3-pre", "v1.2.0-0.20230101120000-abcdef123456"} {
+		if _, err := version.ParsePseudoVersion(s); nil == err {
+			t.Errorf("ParsePseudoVersion(%q) expected error", s)
+		}
+	}
+
+	p, _ := version.ParsePseudoVersion("v1.2.4-0.20230101120000-abcdef123456")
+	for v, want := range map[string]int{
+		"v1.2.3": 1, "1.2.4-rc.1": -1, "v1.2.4": -1, "1.3.0": -1,
+	} {
+		if got := p.Compare(v); got != want {
+			t.Errorf("Compare(%q) = %d, want %d", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// Component describes the version of a subsystem, plugin, or library
+// registered with Register.
+type Component struct {
+	Name      string
+	Version   string
+	ChangeLog []Change
+}
+
+// registry contains every registered Component, keyed by name.
+var registry = struct {
+	sync.RWMutex
+	m map[string]Component
+}{m: map[string]Component{}}
+
+// Register records the version and changelog of the named component, replacing
+// any previous registration with the same name. If version is empty, the
+// version of the last entry in changelog is used.
+// It is safe to call Register from multiple goroutines.
+// Returns an error if name is empty or the version is missing or invalid.
+func Register(name, version string, changelog []Change) error {
+	if "" == name {
+		return errors.New("register: empty component name")
+	}
+	if "" == version && len(changelog) > 0 {
+		version = changelog[len(changelog)-1].Version
+	}
+	if "" == version {
+		return fmt.Errorf("register %s: no version", name)
+	}
+	if err := validate(version); nil != err {
+		return fmt.Errorf("register %s: %v", name, err)
+	}
+	c := Component{Name: name, Version: version}
+	if len(changelog) > 0 {
+		c.ChangeLog = append([]Change(nil), changelog...)
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.m[name] = c
+	return nil
+}
+
+// Unregister removes the named component from the registry, if it exists.
+func Unregister(name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.m, name)
+}
+
+// Lookup returns the registered component with the given name. Returns false if
+// no such component is registered.
+func Lookup(name string) (Component, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	c, ok := registry.m[name]
+	return c, ok
+}
+
+// Components returns every registered component, sorted by name.
+func Components() []Component {
+	registry.RLock()
+	list := make([]Component, 0, len(registry.m))
+	for _, c := range registry.m {
+		list = append(list, c)
+	}
+	registry.RUnlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// FprintAllVersions writes to given io.Writer w an aligned table of every
+// registered component, its version, and the release date of its latest
+// ChangeLog entry (if any). Returns any error encountered writing to w.
+func FprintAllVersions(w io.Writer) error {
+	b := strings.Builder{}
+	tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "COMPONENT\tVERSION\tDATE")
+	for _, c := range Components() {
+		date := ""
+		if n := len(c.ChangeLog); n > 0 {
+			if t := ParseDate(c.ChangeLog[n-1].Date); nil != t {
+				date = t.Format("2006-01-02")
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Name, c.Version, date)
+	}
+	tw.Flush()
+	for _, line := range strings.SplitAfter(b.String(), "\n") {
+		if "" != line {
+			if _, err := fmt.Fprintln(w, strings.TrimRight(line, " \n")); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PrintAllVersions writes to stdout an aligned table of every registered
+// component, its version, and the release date of its latest ChangeLog entry.
+// Returns any error encountered writing to stdout.
+func PrintAllVersions() error {
+	return FprintAllVersions(os.Stdout)
+}
@@ -0,0 +1,68 @@
+package version_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestRegister(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("plugin%d", i)
+			if err := version.Register(name, fmt.Sprintf("1.%d.0", i), nil); nil != err {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	defer func() {
+		for _, c := range version.Components() {
+			version.Unregister(c.Name)
+		}
+	}()
+
+	list := version.Components()
+	if 8 != len(list) {
+		t.Fatalf("Components() returned %d components, want 8", len(list))
+	}
+	for i, c := range list {
+		if want := fmt.Sprintf("1.%d.0", i); c.Version != want {
+			t.Errorf("component %s version = %q, want %q", c.Name, c.Version, want)
+		}
+	}
+
+	log := []version.Change{{Version: "0.1.0"}, {Version: "0.2.0"}}
+	if err := version.Register("core", "", log); nil != err {
+		t.Fatal(err)
+	}
+	if c, ok := version.Lookup("core"); !ok || "0.2.0" != c.Version || 2 != len(c.ChangeLog) {
+		t.Errorf("Lookup(core) = %+v, %t", c, ok)
+	}
+	for _, v := range []string{"", "1.2"} {
+		if err := version.Register("bad", v, nil); nil == err {
+			t.Errorf("Register(bad, %q) expected error", v)
+		}
+	}
+}
+
+func ExampleFprintAllVersions() {
+	version.Register("storage", "2.1.0", []version.Change{
+		{Version: "2.1.0", Date: "Feb 26, 2020"},
+	})
+	version.Register("auth-plugin", "0.3.1-beta", nil)
+	defer version.Unregister("storage")
+	defer version.Unregister("auth-plugin")
+
+	version.PrintAllVersions()
+
+	// Output:
+	// COMPONENT    VERSION     DATE
+	// auth-plugin  0.3.1-beta
+	// storage      2.1.0       2020-02-26
+}
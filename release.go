@@ -0,0 +1,118 @@
+package version
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// TagName returns the git tag name used for the release of a given version.
+func TagName(version string) string {
+	return "v" + version
+}
+
+// apiError is returned when a hosting service responds with an unsuccessful
+// HTTP status.
+type apiError struct {
+	status int
+	msg    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.status, http.StatusText(e.status), e.msg)
+}
+
+func isNotFound(err error) bool {
+	if e, ok := err.(*apiError); ok {
+		return http.StatusNotFound == e.status
+	}
+	return false
+}
+
+// doJSON sends a request with the JSON encoding of in (if non-nil) as body and
+// decodes the JSON response into out (if non-nil).
+func doJSON(ctx context.Context, client *http.Client, method, url string,
+	header http.Header, in, out interface{}) error {
+	var body io.Reader
+	if nil != in {
+		b, err := json.Marshal(in)
+		if nil != err {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if nil != err {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if nil != in {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if nil == client {
+		client = http.DefaultClient
+	}
+	rsp, err := client.Do(req)
+	if nil != err {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(rsp.Body, 512))
+		return &apiError{status: rsp.StatusCode, msg: strings.TrimSpace(string(msg))}
+	}
+	if nil == out {
+		return nil
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
+
+// releaseName returns the display name of the release for Change c.
+func releaseName(c Change) string {
+	if "" != c.Title {
+		return c.Title
+	}
+	return TagName(c.Version)
+}
+
+// releaseBody returns a Markdown list of each line in the description of
+// Change c.
+func releaseBody(c Change) string {
+	b := strings.Builder{}
+	for _, line := range c.Description {
+		b.WriteString("- ")
+		b.WriteString(line)
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
+
+// releaseChange constructs a Change from the details of a release. Returns false
+// if the tag is not a valid semantic version.
+func releaseChange(tag, name, date, body string) (Change, bool) {
+	ver := strings.TrimPrefix(tag, "v")
+	if !IsValid(ver) {
+		return Change{}, false
+	}
+	c := Change{Version: ver, Date: date}
+	if name != tag && name != ver {
+		c.Title = name
+	}
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		for _, bullet := range []string{"- ", "* ", "+ "} {
+			line = strings.TrimPrefix(line, bullet)
+		}
+		if "" != line {
+			c.Description = append(c.Description, line)
+		}
+	}
+	return c, true
+}
@@ -0,0 +1,55 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReleaseNotesFormat is the name of the Formatter used by ReleaseNotes.
+var ReleaseNotesFormat = FormatMarkdown
+
+// ReleaseNotes returns the entry in ChangeLog with the given version, formatted
+// by the Formatter named ReleaseNotesFormat. The result is suitable for use as
+// the notes of a single release, e.g., with "gh release create --notes-file".
+// Returns an error if the version is invalid or not found in ChangeLog, or if
+// the entry could not be formatted.
+func ReleaseNotes(version string) (string, error) {
+	b := strings.Builder{}
+	if err := FprintReleaseNotes(&b, version, ReleaseNotesFormat); nil != err {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// FprintReleaseNotes writes to given io.Writer w the entry in ChangeLog with the
+// given version, formatted by the Formatter registered with the given name.
+// Entries with invalid version strings are ignored.
+// Returns an error if the version is invalid or not found in ChangeLog, if no
+// such Formatter is registered, or if the entry could not be written to w.
+func FprintReleaseNotes(w io.Writer, version, format string) error {
+	cfg := globalConfig()
+	if err := cfg.Validate(version); nil != err {
+		return err
+	}
+	f, ok := LookupFormatter(format)
+	if !ok {
+		return fmt.Errorf("release notes: unknown formatter %q", format)
+	}
+	invalid := 0
+	for i := range ChangeLog {
+		c := &ChangeLog[i]
+		if nil != cfg.Validate(c.Version) {
+			invalid++
+			continue
+		}
+		if 0 == cfg.compare(c.Version, version) {
+			return f.FormatChange(w, c)
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("release notes: version %s not found in changelog "+
+			"(%d entries with invalid versions ignored)", version, invalid)
+	}
+	return fmt.Errorf("release notes: version %s not found in changelog", version)
+}
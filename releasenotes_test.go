@@ -0,0 +1,40 @@
+package version_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleReleaseNotes() {
+	notes, err := version.ReleaseNotes("0.2.0-beta")
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(notes)
+	// Output:
+	// ## [0.2.0-beta+red] - 2020-03-09 - Red Label
+	// - add feature: Dude
+	// - fix bug: Sweet
+}
+
+func TestReleaseNotesErrors(t *testing.T) {
+	for _, v := range []string{"9.9.9", "1.02.3"} {
+		if _, err := version.ReleaseNotes(v); nil == err {
+			t.Errorf("ReleaseNotes(%q) did not return an error", v)
+		}
+	}
+
+	// a bad historical entry does not prevent finding another
+	defer func(log version.History) { version.ChangeLog = log }(version.ChangeLog)
+	version.ChangeLog = version.History{{Version: "bogus"}, {Version: "1.0.0", Title: "good"}}
+	if notes, err := version.ReleaseNotes("1.0.0"); nil != err || !strings.Contains(notes, "good") {
+		t.Errorf("ReleaseNotes(1.0.0) = %q, %v", notes, err)
+	}
+	if _, err := version.ReleaseNotes("2.0.0"); nil == err || !strings.Contains(err.Error(), "1 entries") {
+		t.Errorf("ReleaseNotes(2.0.0) error = %v, want note of invalid entries", err)
+	}
+}
@@ -0,0 +1,474 @@
+package version
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RenderOptions configures the layout of a Change formatted by Layout.
+type RenderOptions struct {
+	Width      int       // width of the header, in runes
+	Rule       rune      // character repeated to draw the header's horizontal rules
+	Margin     int       // number of spaces surrounding the header contents
+	Indent     int       // number of spaces preceding each line of the description
+	Hang       int       // additional spaces preceding wrapped continuation lines
+	Wrap       bool      // wrap description lines longer than Width
+	QuoteTitle bool      // enclose the title in double quotes
+	Color      ColorMode // emit ANSI escape sequences to highlight content
+
+	// Location, if non-nil, overrides DateTimeLocation as the time zone in
+	// which the date is written.
+	Location *time.Location
+
+	// Dates selects whether the date is written in absolute (DateTimeFormat)
+	// or relative ("3 days ago") terms, or both.
+	Dates DateStyle
+
+	// ASCII restricts decorations such as horizontal rules to ASCII characters,
+	// for consoles and log viewers that cannot display Unicode. A non-ASCII
+	// Rule is replaced with '-'.
+	ASCII bool
+
+	// Hyperlinks enables OSC 8 escape sequences that make issue references,
+	// reference URLs, and artifact URLs clickable in terminals supporting them.
+	// Like highlighting, hyperlinks are only written if Color is ColorAlways, or
+	// ColorAuto and writing to a terminal.
+	Hyperlinks bool
+
+	// Labels, if non-nil, overrides DefaultLabels as the literal strings
+	// written in the output, such as "version" and "Authors".
+	Labels *Labels
+}
+
+// ColorMode determines when ANSI escape sequences are used to highlight the
+// version (bold), date (dim), and breaking changes (red) of a Change.
+type ColorMode int
+
+// Constants defining each ColorMode.
+const (
+	ColorNever  ColorMode = iota // never use color
+	ColorAuto                    // use color when writing to a terminal
+	ColorAlways                  // always use color
+)
+
+// DateStyle determines how the date of a Change is written.
+type DateStyle int
+
+// Constants defining each DateStyle.
+const (
+	DateAbsolute DateStyle = iota // "Mon, 09 Mar 2020 17:45:23 UTC"
+	DateRelative                  // "3 days ago"
+	DateBoth                      // "Mon, 09 Mar 2020 17:45:23 UTC (3 days ago)"
+)
+
+// RelativeTime returns a humanized description of time t relative to the given
+// current time, such as "just now", "5 minutes ago", "8 months ago", or
+// "in 2 days" (for times in the future).
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	const day = 24 * time.Hour
+	var n int
+	var unit string
+	switch {
+	case d < 45*time.Second:
+		return "just now"
+	case d < time.Hour:
+		n, unit = int((d+30*time.Second)/time.Minute), "minute"
+	case d < day:
+		n, unit = int((d+30*time.Minute)/time.Hour), "hour"
+	case d < 30*day:
+		n, unit = int(d/day), "day"
+	case d < 365*day:
+		n, unit = int(d/(30*day)), "month"
+	default:
+		n, unit = int(d/(365*day)), "year"
+	}
+	if n < 1 {
+		n = 1
+	}
+	if n > 1 {
+		unit += "s"
+	}
+	if future {
+		return fmt.Sprintf("in %d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %s ago", n, unit)
+}
+
+// ANSI Select Graphic Rendition parameters used for highlighting.
+const (
+	sgrBold   = "1"
+	sgrDim    = "2"
+	sgrRed    = "31"
+	sgrYellow = "33"
+)
+
+// paint returns s enclosed in the ANSI escape sequences for the given SGR
+// parameter if opts.Color is ColorAlways; otherwise, returns s unmodified.
+func (opts RenderOptions) paint(s, sgr string) string {
+	if ColorAlways != opts.Color || "" == s || "" == sgr {
+		return s
+	}
+	return "\x1b[" + sgr + "m" + s + "\x1b[0m"
+}
+
+// link returns text enclosed in the OSC 8 escape sequences of a hyperlink to
+// the given URL if opts.Hyperlinks is set and opts.Color is ColorAlways;
+// otherwise, returns text unmodified.
+func (opts RenderOptions) link(text, url string) string {
+	if !opts.Hyperlinks || ColorAlways != opts.Color || "" == text || "" == url {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// linkIssues returns line with each issue reference (e.g., "#123") replaced by
+// a hyperlink to its URL (see IssueURLFormat and link).
+func (opts RenderOptions) linkIssues(line string) string {
+	if !opts.Hyperlinks || ColorAlways != opts.Color || "" == IssueURLFormat {
+		return line
+	}
+	b := strings.Builder{}
+	last := 0
+	for _, m := range issuePattern.FindAllStringSubmatchIndex(line, -1) {
+		n, err := strconv.Atoi(line[m[4]:m[5]])
+		if nil != err {
+			continue
+		}
+		b.WriteString(line[last:m[2]])
+		b.WriteString(opts.link(line[m[2]:m[3]], fmt.Sprintf(IssueURLFormat, n)))
+		last = m[3]
+	}
+	b.WriteString(line[last:])
+	return b.String()
+}
+
+// resolve returns a copy of opts with ColorAuto replaced by ColorAlways if w is
+// a terminal and color is not disabled by the environment (NO_COLOR or
+// TERM=dumb), or ColorNever otherwise.
+func (opts RenderOptions) resolve(w io.Writer) RenderOptions {
+	if ColorAuto == opts.Color {
+		opts.Color = ColorNever
+		if _, noColor := os.LookupEnv("NO_COLOR"); !noColor &&
+			"dumb" != os.Getenv("TERM") && IsTerminal(w) {
+			opts.Color = ColorAlways
+		}
+	}
+	return opts
+}
+
+// IsTerminal returns true if and only if w is a file connected to a terminal
+// (character device).
+func IsTerminal(w io.Writer) bool {
+	if f, ok := w.(*os.File); ok {
+		if fi, err := f.Stat(); nil == err {
+			return 0 != fi.Mode()&os.ModeCharDevice
+		}
+	}
+	return false
+}
+
+// isBreaking returns true if the given line of a description begins with a
+// breaking change marker, such as "BREAKING CHANGE:" or "BREAKING:".
+func isBreaking(line string) bool {
+	for _, prefix := range []string{"BREAKING CHANGE:", "BREAKING-CHANGE:", "BREAKING:"} {
+		if len(line) >= len(prefix) && strings.EqualFold(line[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRenderOptions defines the layout used by Change.String.
+var DefaultRenderOptions = defaultRenderOptions
+
+// defaultRenderOptions is the initial value of DefaultRenderOptions, used by
+// NewConfig.
+var defaultRenderOptions = RenderOptions{
+	Width:      80,
+	Rule:       '―',
+	Margin:     1,
+	Indent:     2,
+	Hang:       2,
+	Wrap:       true,
+	QuoteTitle: true,
+	Hyperlinks: true,
+}
+
+// Layout returns a formatted, multi-line string describing Change c, consisting
+// of a header box containing the version, title, and date, followed by each
+// line of the description. The layout is configured by opts. Since no output
+// device is known, ColorAuto is treated as ColorNever.
+// It panics if the version string is invalid.
+func (c *Change) Layout(opts RenderOptions) string {
+	mustValidate(c.Version) // validate version string. will panic if invalid.
+	cfg := globalConfig()
+	cfg.Render = opts
+	b := strings.Builder{}
+	c.layout(&b, cfg)
+	return b.String()
+}
+
+// layout writes the formatted description of Change c to w, as returned by
+// Layout, using the RenderOptions and date-time settings of cfg. The version
+// string is not validated.
+func (c *Change) layout(b io.Writer, cfg *Config) {
+	opts := cfg.Render
+	labels := opts.labels()
+	runeRepeat := func(c rune, n int) string {
+		b := strings.Builder{}
+		for i := 0; i < n; i++ {
+			b.WriteRune(c)
+		}
+		return b.String()
+	}
+
+	// construct the "package module version - title" left-hand side
+	vsb := strings.Builder{}
+	vlen := 0 // number of visible runes, excluding escape sequences
+	for _, name := range []string{c.Package, c.Module} {
+		if "" != name {
+			vsb.WriteString(name)
+			vsb.WriteRune(' ')
+			vlen += utf8.RuneCountInString(name) + 1
+		}
+	}
+	vsb.WriteString(labels.Version)
+	vsb.WriteRune(' ')
+	vsb.WriteString(opts.paint(c.Version, sgrBold))
+	vlen += utf8.RuneCountInString(labels.Version) + 1 + utf8.RuneCountInString(c.Version)
+	badge := func(label, sgr string) {
+		vsb.WriteRune(' ')
+		vsb.WriteString(opts.paint("["+label+"]", sgr))
+		vlen += utf8.RuneCountInString(label) + 3
+	}
+	if c.Breaking {
+		badge(labels.Breaking, sgrRed)
+	}
+	if c.Yanked {
+		badge(labels.Yanked, sgrYellow)
+	}
+	if cfg.isUnsupported(c.Version) {
+		badge(labels.Unsupported, sgrDim)
+	}
+	if "" != c.Title {
+		title := c.Title
+		if opts.QuoteTitle {
+			title = fmt.Sprintf("%q", c.Title)
+		}
+		vsb.WriteString(" - ")
+		vsb.WriteString(title)
+		vlen += len(" - ") + utf8.RuneCountInString(title)
+	}
+
+	// construct the "date" right-hand side
+	date := ""
+	loc := opts.Location
+	if nil == loc {
+		loc = cfg.DateTimeLocation
+	}
+	if t := parseDateIn(c.Date, loc); nil != t {
+		switch opts.Dates {
+		case DateAbsolute:
+			date = labels.formatDate(*t, cfg.DateTimeFormat)
+		case DateRelative:
+			date = labels.relativeTime(*t, cfg.Now())
+		case DateBoth:
+			date = labels.formatDate(*t, cfg.DateTimeFormat) +
+				" (" + labels.relativeTime(*t, cfg.Now()) + ")"
+		}
+	}
+	dsb := strings.Builder{}
+	dsb.WriteString(opts.paint(date, sgrDim))
+
+	// calculate the padding width between left- and right-hand sides
+	middlePad := opts.Width - ((vlen + opts.Margin) +
+		(utf8.RuneCountInString(date) + opts.Margin))
+	if middlePad < 1 {
+		middlePad = 1 // always separate the date from an overlong title
+	}
+
+	// horizontal line used for containing the header
+	rule := opts.Rule
+	if opts.ASCII && rule > unicode.MaxASCII {
+		rule = '-'
+	}
+	horizLine := runeRepeat(rule, opts.Width) + "\n"
+
+	// construct the header containing horizontal lines, version, title, and date
+	io.WriteString(b, horizLine)
+	fmt.Fprintf(b, "%*s%s", opts.Margin, "", vsb.String())
+	if dsb.Len() > 0 {
+		fmt.Fprintf(b, "%*s%s", middlePad, "", dsb.String())
+	}
+	io.WriteString(b, "\n")
+	io.WriteString(b, horizLine)
+
+	// writeLine appends a line with indentation, wrapped if enabled
+	writeLine := func(line, sgr string) {
+		wrapped := []string{line}
+		if opts.Wrap {
+			wrapped = wrap(line, opts.Width-opts.Indent, opts.Width-opts.Indent-opts.Hang)
+		}
+		for i, w := range wrapped {
+			pad := opts.Indent
+			if i > 0 {
+				pad += opts.Hang
+			}
+			fmt.Fprintf(b, "%*s%s\n", pad, "", opts.paint(opts.linkIssues(w), sgr))
+		}
+	}
+
+	// append each description line
+	for _, line := range c.Description {
+		sgr := ""
+		if isBreaking(line) {
+			sgr = sgrRed
+		}
+		writeLine(line, sgr)
+	}
+
+	// append the features that became available (see Since)
+	if names := featuresSince(cfg, c.Version); len(names) > 0 {
+		writeLine(labels.Features+": "+strings.Join(names, ", "), "")
+	}
+
+	// append the footer crediting each author
+	if len(c.Authors) > 0 {
+		writeLine(labels.Authors+": "+strings.Join(c.Authors, ", "), sgrDim)
+	}
+
+	// append each deprecation with its planned removal
+	if len(c.Deprecations) > 0 {
+		fmt.Fprintf(b, "%*s%s:\n", opts.Indent, "", labels.Deprecated)
+		for _, d := range c.Deprecations {
+			fmt.Fprintf(b, "%*s%s\n", opts.Indent+opts.Hang, "",
+				opts.paint(d.String(), sgrYellow))
+		}
+	}
+
+	// append each artifact with its platform, checksum, and URL
+	if len(c.Artifacts) > 0 {
+		fmt.Fprintf(b, "%*s%s:\n", opts.Indent, "", labels.Artifacts)
+		for _, a := range c.Artifacts {
+			pad := opts.Indent + opts.Hang
+			fmt.Fprintf(b, "%*s%s", pad, "", a.Name)
+			if "" != a.Platform {
+				fmt.Fprintf(b, " (%s)", a.Platform)
+			}
+			io.WriteString(b, "\n")
+			if "" != a.SHA256 {
+				fmt.Fprintf(b, "%*ssha256 %s\n", pad+opts.Hang, "", a.SHA256)
+			}
+			if "" != a.URL {
+				fmt.Fprintf(b, "%*s%s\n", pad+opts.Hang, "", opts.link(a.URL, a.URL))
+			}
+		}
+	}
+
+	// append footnotes listing each reference with a known URL; these are not
+	// wrapped, since URLs cannot be split.
+	n, unlabeled := 0, 0
+	for _, ref := range c.References() {
+		if "" == ref.URL {
+			continue
+		}
+		if n++; 1 == n {
+			io.WriteString(b, "\n")
+		}
+		label := ref.Label
+		if "" == label {
+			unlabeled++
+			label = fmt.Sprintf("%d", unlabeled)
+		}
+		fmt.Fprintf(b, "%*s[%s] %s\n", opts.Indent, "",
+			opts.link(label, ref.URL), opts.link(ref.URL, ref.URL))
+	}
+}
+
+// WriteTo writes the formatted description of Change c to w, as returned by
+// String, except that ColorAuto in DefaultRenderOptions is resolved for w.
+// Output is written as it is formatted rather than first built in memory.
+// It returns the number of bytes written and any error encountered, including
+// an invalid version string or template failure.
+func (c *Change) WriteTo(w io.Writer) (int64, error) {
+	return globalConfig().WriteChange(w, c)
+}
+
+// stream writes the formatted description of Change c to dst, using the
+// settings of cfg and resolving ColorAuto for the output device w. Returns an
+// invalid version string or template failure, which takes precedence over any
+// error writing to dst.
+func (c *Change) stream(dst, w io.Writer, cfg *Config) error {
+	if err := cfg.Validate(c.Version); nil != err {
+		return err
+	}
+	bw := bufio.NewWriter(dst)
+	var err error
+	if nil != cfg.Template {
+		err = c.Execute(bw, cfg.Template)
+	} else {
+		resolved := *cfg
+		resolved.Render = cfg.Render.resolve(w)
+		c.layout(bw, &resolved)
+	}
+	// write any partial output, but report the template's error first
+	if ferr := bw.Flush(); nil == err {
+		err = ferr
+	}
+	return err
+}
+
+// countWriter is an io.Writer that counts the bytes written to the underlying
+// io.Writer and records the first error encountered, after which all writes
+// are discarded.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// Write implements io.Writer.
+func (cw *countWriter) Write(p []byte) (int, error) {
+	if nil != cw.err {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+// wrap splits the given line at spaces into lines of at most first runes (for
+// the first line) and rest runes (for each subsequent line). Words longer than
+// the available width are not split.
+func wrap(line string, first, rest int) []string {
+	words := strings.Fields(line)
+	if len(words) < 2 || utf8.RuneCountInString(line) <= first {
+		return []string{line}
+	}
+	var lines []string
+	cur, n, max := words[0], utf8.RuneCountInString(words[0]), first
+	for _, word := range words[1:] {
+		wn := utf8.RuneCountInString(word)
+		if n+1+wn > max {
+			lines = append(lines, cur)
+			cur, n, max = word, wn, rest
+			continue
+		}
+		cur += " " + word
+		n += 1 + wn
+	}
+	return append(lines, cur)
+}
@@ -0,0 +1,151 @@
+package version_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2020, time.March, 9, 17, 45, 23, 0, time.UTC)
+	for d, want := range map[time.Duration]string{
+		10 * time.Second:         "just now",
+		-10 * time.Second:        "just now",
+		time.Minute:              "1 minute ago",
+		50 * time.Minute:         "50 minutes ago",
+		90 * time.Minute:         "2 hours ago",
+		3 * 24 * time.Hour:       "3 days ago",
+		-3 * 24 * time.Hour:      "in 3 days",
+		245 * 24 * time.Hour:     "8 months ago",
+		2 * 365 * 24 * time.Hour: "2 years ago",
+	} {
+		if got := version.RelativeTime(now.Add(-d), now); got != want {
+			t.Errorf("RelativeTime(now-%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+// failWriter accepts n bytes and then fails every write.
+type failWriter struct{ n int }
+
+func (f *failWriter) Write(p []byte) (int, error) {
+	if len(p) > f.n {
+		p = p[:f.n]
+	}
+	f.n -= len(p)
+	if 0 == f.n {
+		return len(p), errors.New("write failed")
+	}
+	return len(p), nil
+}
+
+func TestChangeWriteTo(t *testing.T) {
+	c := version.Change{Version: "1.2.3", Title: "hello", Description: []string{"world"}}
+	var b strings.Builder
+	n, err := c.WriteTo(&b)
+	if nil != err || b.String() != c.String() || n != int64(b.Len()) {
+		t.Errorf("WriteTo = %d, %v; wrote %q, want %q", n, err, b.String(), c.String())
+	}
+	if n, err = c.WriteTo(&failWriter{n: 10}); nil == err || 10 != n {
+		t.Errorf("WriteTo(failWriter) = %d, %v; want 10, error", n, err)
+	}
+	c.Version = "1.2"
+	if _, err = c.WriteTo(&b); nil == err {
+		t.Errorf("WriteTo(invalid) = nil error")
+	}
+
+	// a template failure is reported even if the output also fails
+	tmpl, _ := version.NewTemplate("change", `{{.Version}} {{semver "bad"}}`)
+	cfg := version.NewConfig(version.WithTemplate(tmpl))
+	c.Version = "1.2.3"
+	if _, err = cfg.WriteChange(&failWriter{n: 3}, &c); nil == err ||
+		!strings.Contains(err.Error(), "semver") {
+		t.Errorf("WriteChange(failing template, failWriter) = %v, want template error", err)
+	}
+}
+
+func TestFprintErrors(t *testing.T) {
+	defer func(log []version.Change, v version.Semver) {
+		version.ChangeLog, version.Version = log, v
+	}(version.ChangeLog, version.Version)
+	version.Version = version.Semver{}
+
+	version.ChangeLog = []version.Change{{Version: "1.0.0"}}
+	if err := version.FprintChangeLog(&failWriter{n: 5}); nil == err {
+		t.Errorf("FprintChangeLog(failWriter) = nil error")
+	}
+	if err := version.FprintPackageVersion(&failWriter{n: 5}); nil == err {
+		t.Errorf("FprintPackageVersion(failWriter) = nil error")
+	}
+
+	version.ChangeLog = []version.Change{{Version: "1.0"}}
+	var b strings.Builder
+	if err := version.FprintChangeLog(&b); nil == err {
+		t.Errorf("FprintChangeLog(invalid) = nil error")
+	}
+	if err := version.FprintPackageVersion(&b); nil == err {
+		t.Errorf("FprintPackageVersion(invalid) = nil error")
+	}
+	if err := version.FprintBuildInfo(&b, ""); nil == err {
+		t.Errorf("FprintBuildInfo(invalid) = nil error")
+	}
+}
+
+func TestNow(t *testing.T) {
+	defer func(now func() time.Time) { version.Now = now }(version.Now)
+	version.Now = func() time.Time {
+		return time.Date(2020, time.March, 12, 17, 45, 23, 0, time.UTC)
+	}
+	c := version.Change{Version: "1.0.0", Date: "2020-03-09T17:45:23Z"}
+	opts := version.RenderOptions{Width: 40, Rule: '-', Dates: version.DateRelative}
+	if got := c.Layout(opts); !strings.Contains(got, "3 days ago") {
+		t.Errorf("Layout(DateRelative) = %q, want \"3 days ago\"", got)
+	}
+}
+
+func TestHyperlinks(t *testing.T) {
+	defer func(format string) { version.IssueURLFormat = format }(version.IssueURLFormat)
+	version.IssueURLFormat = "https://example.com/issues/%d"
+	c := version.Change{
+		Version:     "1.2.3",
+		Description: []string{"fix crash (#12)"},
+		Artifacts:   []version.Artifact{{Name: "app.tgz", URL: "https://example.com/app.tgz"}},
+	}
+	osc8 := func(text, url string) string {
+		return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+	}
+	opts := version.RenderOptions{Width: 40, Rule: '-', Indent: 2, Hang: 2,
+		Color: version.ColorAlways, Hyperlinks: true}
+	out := c.Layout(opts)
+	for _, want := range []string{
+		"fix crash (" + osc8("#12", "https://example.com/issues/12") + ")",
+		osc8("https://example.com/app.tgz", "https://example.com/app.tgz"),
+		"[" + osc8("#12", "https://example.com/issues/12") + "] ",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%q", want, out)
+		}
+	}
+	opts.Color = version.ColorNever
+	if out := c.Layout(opts); strings.Contains(out, "\x1b]8;;") {
+		t.Errorf("hyperlinks written without color:\n%q", out)
+	}
+}
+
+func TestChangeRender(t *testing.T) {
+	c := version.Change{Version: "1.2", Title: "hello", Description: []string{"world"}}
+	if _, err := c.Render(); nil == err {
+		t.Error("Render(invalid) = nil error")
+	}
+	s := c.String() // must not panic
+	if !strings.HasPrefix(s, `version 1.2 - "hello" [`) || !strings.HasSuffix(s, "]\n  world\n") {
+		t.Errorf("String(invalid) = %q", s)
+	}
+	c.Version = "1.2.3"
+	if r, err := c.Render(); nil != err || r != c.String() {
+		t.Errorf("Render() = %q, %v; want %q", r, err, c.String())
+	}
+}
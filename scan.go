@@ -0,0 +1,96 @@
+package version
+
+// semverPattern is the default value of VersionPattern. While VersionPattern is
+// unchanged, versions are parsed with scanSemver instead of the (much slower)
+// regular expression.
+const semverPattern = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+
+// ParseSemver parses a semantic version string into its components, returning
+// an error if the string is invalid. Unlike Parse, it always validates with a
+// hand-written scanner equivalent to the default VersionPattern, and it never
+// allocates memory for valid version strings; the Prerelease and Metadata of the
+// returned Semver share storage with the given string.
+func ParseSemver(version string) (Semver, error) {
+	if v, ok := scanSemver(version); ok {
+		return v, nil
+	}
+	return Semver{}, diagnose(version)
+}
+
+// scanSemver parses a semantic version string without using regular
+// expressions or allocating memory. Returns false if the string is invalid.
+func scanSemver(s string) (v Semver, ok bool) {
+	var i int
+	if v.Major, i, ok = scanNumber(s, 0); !ok || i >= len(s) || '.' != s[i] {
+		return Semver{}, false
+	}
+	if v.Minor, i, ok = scanNumber(s, i+1); !ok || i >= len(s) || '.' != s[i] {
+		return Semver{}, false
+	}
+	if v.Patch, i, ok = scanNumber(s, i+1); !ok {
+		return Semver{}, false
+	}
+	if i < len(s) && '-' == s[i] {
+		start := i + 1
+		if i, ok = scanIdentifiers(s, start, true); !ok {
+			return Semver{}, false
+		}
+		v.Prerelease = s[start:i]
+	}
+	if i < len(s) && '+' == s[i] {
+		start := i + 1
+		if i, ok = scanIdentifiers(s, start, false); !ok {
+			return Semver{}, false
+		}
+		v.Metadata = s[start:i]
+	}
+	if i != len(s) {
+		return Semver{}, false
+	}
+	return v, true
+}
+
+// scanNumber parses a numeric identifier without leading zeros beginning at
+// index i of s. Returns the number and the index following it.
+func scanNumber(s string, i int) (n uint, end int, ok bool) {
+	const max = ^uint(0)
+	start := i
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		d := uint(s[i] - '0')
+		if n > (max-d)/10 {
+			return 0, i, false // overflow
+		}
+		n = n*10 + d
+	}
+	if i == start || (i-start > 1 && '0' == s[start]) {
+		return 0, i, false
+	}
+	return n, i, true
+}
+
+// scanIdentifiers parses one or more dot-separated identifiers beginning at
+// index i of s, stopping at the first '+' or end of string. If prerelease is
+// true, numeric identifiers must not have leading zeros.
+// Returns the index following the last identifier.
+func scanIdentifiers(s string, i int, prerelease bool) (end int, ok bool) {
+	for {
+		start, numeric := i, true
+		for ; i < len(s) && '.' != s[i] && '+' != s[i]; i++ {
+			switch c := s[i]; {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', '-' == c:
+				numeric = false
+			default:
+				return i, false
+			}
+		}
+		if i == start ||
+			(prerelease && numeric && i-start > 1 && '0' == s[start]) {
+			return i, false
+		}
+		if i >= len(s) || '.' != s[i] {
+			return i, true
+		}
+		i++ // skip '.'
+	}
+}
@@ -0,0 +1,110 @@
+package version_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+var scanTests = []string{
+	"0.0.0", "1.2.3", "10.20.30", "1.1.2-prerelease+meta", "1.1.2+meta",
+	"1.1.2+meta-valid", "1.0.0-alpha", "1.0.0-alpha.beta.1", "1.0.0-alpha.0valid",
+	"1.0.0-rc.1+build.1", "1.2.3-0123abc", "1.0.0+0.build.1-rc.10000aaa-kk-0.1",
+	"1.2.3----RC-SNAPSHOT.12.9.1--.12+788", "1.0.0-0A.is.legal",
+	"", "1", "1.2", "1.2.3.4", "01.1.1", "1.01.1", "1.1.01", "1.2.3-0123",
+	"1.2.3-", "1.2.3+", "1.2.3-a..b", "1.2.3+a..b", "1.2.3-a.", "+invalid",
+	"-invalid", "1.2.3-a+b+c", "1.2.3-a_b", " 1.2.3", "v1.2.3",
+	"99999999999999999999999.0.0", "1.2.3+01",
+}
+
+func TestParseSemver(t *testing.T) {
+	re := regexp.MustCompile(version.VersionPattern)
+	for _, s := range scanTests {
+		sub := re.FindStringSubmatch(s)
+		valid := nil != sub && "99999999999999999999999.0.0" != s // overflows uint
+		v, err := version.ParseSemver(s)
+		if valid != (nil == err) {
+			t.Errorf("ParseSemver(%q) error = %v, want valid = %t", s, err, valid)
+			continue
+		}
+		if valid && (v.String() != s || v.Prerelease != sub[4] || v.Metadata != sub[5]) {
+			t.Errorf("ParseSemver(%q) = %+v", s, v)
+		}
+	}
+}
+
+func TestParseSemverAllocs(t *testing.T) {
+	const s = "1.2.3-rc.1+build.42"
+	if n := testing.AllocsPerRun(100, func() { version.ParseSemver(s) }); 0 != n {
+		t.Errorf("ParseSemver(%q) allocs = %v, want 0", s, n)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		version.Parse("1.2.3-rc.1+build.42")
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for v, want := range map[string]string{
+		"1.02.3":                   "leading zero in minor component at position 3",
+		"1.2":                      "missing patch component at position 4",
+		"1..3":                     "missing minor component at position 3",
+		"x.2.3":                    "invalid character 'x' in major component at position 1",
+		"1.2.3x":                   "invalid character 'x' in patch component at position 6",
+		"1.2.3-rc..1":              "empty identifier in prerelease component at position 10",
+		"1.2.3-rc.01":              "leading zero in prerelease component at position 10",
+		"1.2.3+a_b":                "invalid character '_' in metadata component at position 8",
+		"1.2.3+a+b":                "invalid character '+' in metadata component at position 8",
+		"1.2.3-":                   "empty identifier in prerelease component at position 7",
+		"99999999999999999999.0.0": "overflow in major component at position 1",
+	} {
+		_, err := version.ParseSemver(v)
+		perr, ok := err.(*version.ParseError)
+		if !ok {
+			t.Errorf("ParseSemver(%q) error = %v, want *ParseError", v, err)
+			continue
+		}
+		if got := perr.Error(); got != fmt.Sprintf("invalid version %q: %s", v, want) {
+			t.Errorf("ParseSemver(%q) error = %s, want %s", v, got, want)
+		}
+	}
+	for _, v := range scanTests {
+		if _, err := version.ParseSemver(v); nil != err {
+			if perr, ok := err.(*version.ParseError); !ok || "" == perr.Component {
+				t.Errorf("ParseSemver(%q) error = %v, want component", v, err)
+			}
+		}
+	}
+}
+
+func TestSetVersionPattern(t *testing.T) {
+	defer func(p string) { version.VersionPattern = p }(version.VersionPattern)
+	if err := version.SetVersionPattern(`(`); nil == err {
+		t.Error("SetVersionPattern(`(`): expected error")
+	}
+	if err := version.SetVersionPattern(`^v(\d+)\.(\d+)\.(\d+)$`); nil != err {
+		t.Fatal(err)
+	}
+	if !version.IsValid("v1.2.3") || version.IsValid("1.2.3") {
+		t.Error("IsValid does not use the pattern given to SetVersionPattern")
+	}
+	// assigning VersionPattern directly also replaces the compiled pattern
+	version.VersionPattern = `^r(\d+)\.(\d+)\.(\d+)$`
+	if major, minor, patch, _, _ := version.Parse("r4.5.6"); 4 != major || 5 != minor || 6 != patch {
+		t.Errorf("Parse(%q) = %d.%d.%d", "r4.5.6", major, minor, patch)
+	}
+}
+
+func BenchmarkParsePattern(b *testing.B) {
+	defer func(p string) { version.VersionPattern = p }(version.VersionPattern)
+	version.VersionPattern = `^v(\d+)\.(\d+)\.(\d+)$`
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		version.Parse("v1.2.3")
+	}
+}
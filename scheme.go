@@ -0,0 +1,128 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scheme defines a version numbering scheme, so that ChangeLog, rendering, and
+// bumping work regardless of how a project numbers its versions. Assign a
+// Scheme to VersionScheme (or Config.Scheme) to use it; applications may
+// implement their own.
+type Scheme interface {
+	// Validate returns an error if the given version string is invalid.
+	Validate(version string) error
+
+	// Compare returns an integer comparing the precedence of two valid version
+	// strings: 0 if a == b, -1 if a < b, and +1 if a > b.
+	Compare(a, b string) int
+
+	// IsPrerelease returns true if and only if the given valid version string
+	// identifies a prerelease.
+	IsPrerelease(version string) bool
+
+	// Bump returns the version that follows the given valid version string, or
+	// the first version if it is empty. The part (e.g., "major", "minor", or
+	// "patch") selects the component incremented, if the scheme has several.
+	Bump(version, part string) (string, error)
+}
+
+// VersionScheme, if non-nil, defines the numbering scheme of the package
+// version and the entries in ChangeLog, overriding both CalVerFormat and
+// VersionPattern.
+var VersionScheme Scheme
+
+// SemVerScheme is the Scheme of semantic versions validated by VersionPattern.
+// It is used if VersionScheme and CalVerFormat are undefined.
+var SemVerScheme Scheme = semverScheme{}
+
+// CalVerScheme returns the Scheme of calendar versions in the given format
+// (see CalVerFormat), which is used if VersionScheme is undefined and
+// CalVerFormat is defined. New versions are dated with Now.
+func CalVerScheme(format string) Scheme {
+	return calverScheme{format}
+}
+
+// activeScheme returns the Scheme in use: VersionScheme if defined, or else
+// CalVerScheme(CalVerFormat) if CalVerFormat is defined, or else SemVerScheme.
+func activeScheme() Scheme {
+	switch {
+	case nil != VersionScheme:
+		return VersionScheme
+	case "" != CalVerFormat:
+		return CalVerScheme(CalVerFormat)
+	}
+	return SemVerScheme
+}
+
+// Bump returns the version that follows the given version string according to
+// the Scheme in use (see VersionScheme). For semantic versions, part is one of
+// "major", "minor", or "patch" (see BumpMajor, BumpMinor, and BumpPatch); for
+// calendar versions, it is ignored (see NextCalVer). An empty version string
+// is followed by the first version of the scheme.
+// Returns an error if the version string or part is invalid.
+func Bump(version, part string) (string, error) {
+	return activeScheme().Bump(version, part)
+}
+
+type semverScheme struct{}
+
+func (semverScheme) Validate(version string) error {
+	return NewConfig(WithVersionPattern(VersionPattern)).Validate(version)
+}
+
+func (semverScheme) Compare(a, b string) int {
+	return Compare(a, b)
+}
+
+func (semverScheme) IsPrerelease(version string) bool {
+	_, _, _, pre, _ := Parse(version)
+	return "" != pre
+}
+
+func (s semverScheme) Bump(version, part string) (string, error) {
+	if "" == version {
+		version = "0.0.0"
+	}
+	if err := s.Validate(version); nil != err {
+		return "", err
+	}
+	switch strings.ToLower(part) {
+	case "major":
+		return BumpMajor(version), nil
+	case "minor":
+		return BumpMinor(version), nil
+	case "patch":
+		return BumpPatch(version), nil
+	}
+	return "", fmt.Errorf("bump: expected one of: major, minor, patch (not %q)", part)
+}
+
+type calverScheme struct {
+	format string
+}
+
+func (s calverScheme) Validate(version string) error {
+	_, err := ParseCalVer(s.format, version)
+	return err
+}
+
+func (s calverScheme) Compare(a, b string) int {
+	av, err := ParseCalVer(s.format, a)
+	if nil != err {
+		panic("invalid version: " + err.Error())
+	}
+	bv, err := ParseCalVer(s.format, b)
+	if nil != err {
+		panic("invalid version: " + err.Error())
+	}
+	return av.Compare(bv)
+}
+
+func (calverScheme) IsPrerelease(string) bool {
+	return false // modifiers do not imply a prerelease
+}
+
+func (s calverScheme) Bump(version, _ string) (string, error) {
+	return nextCalVer(s.format, version, Now())
+}
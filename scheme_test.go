@@ -0,0 +1,71 @@
+package version_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ardnew/version"
+)
+
+// revisionScheme numbers versions with an incrementing revision, such as "r42".
+type revisionScheme struct{}
+
+func (revisionScheme) revision(v string) (int, error) {
+	if !strings.HasPrefix(v, "r") {
+		return 0, fmt.Errorf("invalid revision: %q", v)
+	}
+	return strconv.Atoi(v[1:])
+}
+
+func (s revisionScheme) Validate(v string) error {
+	_, err := s.revision(v)
+	return err
+}
+
+func (s revisionScheme) Compare(a, b string) int {
+	x, _ := s.revision(a)
+	y, _ := s.revision(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func (revisionScheme) IsPrerelease(string) bool { return false }
+
+func (s revisionScheme) Bump(v, _ string) (string, error) {
+	n := 0
+	if "" != v {
+		var err error
+		if n, err = s.revision(v); nil != err {
+			return "", err
+		}
+	}
+	return "r" + strconv.Itoa(n+1), nil
+}
+
+func ExampleScheme() {
+	defer func(s version.Scheme, log version.History, v version.Semver) {
+		version.VersionScheme, version.ChangeLog, version.Version = s, log, v
+	}(version.VersionScheme, version.ChangeLog, version.Version)
+
+	version.VersionScheme = revisionScheme{}
+	version.Version = version.Semver{}
+	version.ChangeLog = version.History{
+		{Package: "tool", Version: "r9"},
+		{Package: "tool", Version: "r10"},
+	}
+	latest, _ := version.Latest()
+	next, _ := version.Bump(latest.Version, "")
+	fmt.Println(version.String(), latest.Version, next)
+	fmt.Println(version.AddChange(version.Change{Version: "r2"}))
+	fmt.Println(version.AddChange(version.Change{Version: "v3"}))
+	// Output:
+	// r10 r10 r11
+	// version r2 is not greater than latest version r10
+	// invalid revision: "v3"
+}
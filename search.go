@@ -0,0 +1,38 @@
+package version
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SearchChanges returns each entry in ChangeLog whose title or description
+// matches the given query, in ChangeLog order. A query enclosed in slashes
+// (e.g., "/^fix(ed)? bug/") is a regular expression; any other query matches
+// as a case-insensitive substring. Returns an error if the regular expression
+// is invalid.
+func SearchChanges(query string) ([]Change, error) {
+	match := func(s string) bool {
+		return strings.Contains(strings.ToLower(s), strings.ToLower(query))
+	}
+	if n := len(query); n > 1 && '/' == query[0] && '/' == query[n-1] {
+		re, err := regexp.Compile(query[1 : n-1])
+		if nil != err {
+			return nil, err
+		}
+		match = re.MatchString
+	}
+	var changes []Change
+	for _, c := range ChangeLog {
+		if match(c.Title) {
+			changes = append(changes, c)
+			continue
+		}
+		for _, line := range c.Description {
+			if match(line) {
+				changes = append(changes, c)
+				break
+			}
+		}
+	}
+	return changes, nil
+}
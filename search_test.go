@@ -0,0 +1,35 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestSearchChanges(t *testing.T) {
+	for query, want := range map[string][]string{
+		"dude":          {"0.2.0-beta+red"},
+		"formal":        {"0.1.0+fqt"},
+		"/^(add|fix) /": {"0.2.0-beta+red"},
+		"/manual$/":     {"0.1.0+fqt"},
+		"nothing":       nil,
+	} {
+		got, err := version.SearchChanges(query)
+		if nil != err {
+			t.Errorf("SearchChanges(%q) = %v", query, err)
+			continue
+		}
+		if len(got) != len(want) {
+			t.Errorf("SearchChanges(%q) = %d entries, want %d", query, len(got), len(want))
+			continue
+		}
+		for i, c := range got {
+			if c.Version != want[i] {
+				t.Errorf("SearchChanges(%q)[%d] = %s, want %s", query, i, c.Version, want[i])
+			}
+		}
+	}
+	if _, err := version.SearchChanges("/(/"); nil == err {
+		t.Errorf("SearchChanges(invalid regexp) = nil error")
+	}
+}
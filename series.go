@@ -0,0 +1,123 @@
+package version
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Series is a group of changelog entries sharing a major version ("2.x") or a
+// major and minor version ("2.1.x"), as returned by GroupBySeries.
+type Series struct {
+	Name      string   // "2.x" or "2.1.x"
+	Changes   []Change // entries in the series, newest first
+	Collapsed bool     // series older than the newest major version
+}
+
+// GroupBySeries groups the given entries into series, newest first, to make a
+// long history readable. Entries with the newest major version are grouped by
+// minor version ("2.1.x", "2.0.x"), while those with older major versions are
+// grouped by major version alone ("1.x") and marked Collapsed.
+// Returns an error if any of the version strings are not valid semantic
+// versions.
+func GroupBySeries(log []Change) ([]Series, error) {
+	type entry struct {
+		v Semver
+		c Change
+	}
+	list := make([]entry, len(log))
+	for i, c := range log {
+		v, err := ParseSemver(c.Version)
+		if nil != err {
+			return nil, err
+		}
+		list[i] = entry{v, c}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].v.Compare(list[j].v) > 0
+	})
+	var groups []Series
+	for _, e := range list {
+		name := fmt.Sprintf("%d.x", e.v.Major)
+		collapsed := e.v.Major != list[0].v.Major
+		if !collapsed {
+			name = fmt.Sprintf("%d.%d.x", e.v.Major, e.v.Minor)
+		}
+		if n := len(groups); 0 == n || groups[n-1].Name != name {
+			groups = append(groups, Series{Name: name, Collapsed: collapsed})
+		}
+		s := &groups[len(groups)-1]
+		s.Changes = append(s.Changes, e.c)
+	}
+	return groups, nil
+}
+
+// FprintGroupedChangeLog writes to given io.Writer w the entries in ChangeLog
+// grouped by series (see GroupBySeries), as written by
+// Config.FprintGroupedChangeLog.
+func FprintGroupedChangeLog(w io.Writer) error {
+	return globalConfig().FprintGroupedChangeLog(w, ChangeLog)
+}
+
+// FprintGroupedChangeLog writes to w the given entries grouped by series (see
+// GroupBySeries), each beneath a heading naming the series. Entries in expanded
+// series are formatted as by WriteChange; those in collapsed series are
+// summarized on a single line each, with their version, date, and title.
+// Returns the first error encountered.
+func (cfg *Config) FprintGroupedChangeLog(w io.Writer, log []Change) error {
+	groups, err := GroupBySeries(log)
+	if nil != err {
+		return err
+	}
+	opts := cfg.Render.resolve(w)
+	for _, s := range groups {
+		if _, err := fmt.Fprintf(w, "%s\n\n", opts.paint(s.Name, sgrBold)); nil != err {
+			return err
+		}
+		if s.Collapsed {
+			if err := writeSummary(w, s.Changes, opts.Indent, false); nil != err {
+				return err
+			}
+			if _, err := io.WriteString(w, "\n"); nil != err {
+				return err
+			}
+			continue
+		}
+		if err := cfg.FprintChangeLog(w, s.Changes); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGroupedMarkdown encodes to given io.Writer w the given entries as a
+// Markdown changelog, as written by WriteMarkdown, except that entries are
+// grouped by series (see GroupBySeries) beneath a heading for each series.
+// Collapsed series are enclosed in an HTML <details> element, which most
+// Markdown viewers display folded.
+// Returns an error if any of the version strings are not valid semantic
+// versions, or if the changelog could not be written to w.
+func WriteGroupedMarkdown(w io.Writer, log []Change) error {
+	groups, err := GroupBySeries(log)
+	if nil != err {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	b.WriteString("# Changelog\n")
+	for _, s := range groups {
+		if s.Collapsed {
+			fmt.Fprintf(b, "\n<details>\n<summary>%s</summary>\n", s.Name)
+		} else {
+			fmt.Fprintf(b, "\n## %s\n", s.Name)
+		}
+		for i := range s.Changes {
+			b.WriteString("\n")
+			writeMarkdownEntry(b, &s.Changes[i], 3)
+		}
+		if s.Collapsed {
+			b.WriteString("\n</details>\n")
+		}
+	}
+	return b.Flush()
+}
@@ -0,0 +1,88 @@
+package version_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+var seriesLog = []version.Change{
+	{Version: "1.3.0", Date: "2019-05-01", Title: "Old"},
+	{Version: "1.4.0", Date: "2019-08-01"},
+	{Version: "1.4.1", Date: "2019-09-01", Title: "Fixes", Yanked: true},
+	{Version: "2.0.0", Date: "2020-01-01", Description: []string{"Changed: everything"}},
+	{Version: "2.1.0", Date: "2020-03-09", Description: []string{"Added: more"}},
+}
+
+func ExampleGroupBySeries() {
+	groups, err := version.GroupBySeries(seriesLog)
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
+	for _, s := range groups {
+		fmt.Println(s.Name, len(s.Changes), s.Collapsed)
+	}
+	// Output:
+	// 2.1.x 1 false
+	// 2.0.x 1 false
+	// 1.x 3 true
+}
+
+func ExampleWriteGroupedMarkdown() {
+	version.WriteGroupedMarkdown(os.Stdout, seriesLog)
+	// Output:
+	// # Changelog
+	//
+	// ## 2.1.x
+	//
+	// ### [2.1.0] - 2020-03-09
+	// #### Added
+	// - more
+	//
+	// ## 2.0.x
+	//
+	// ### [2.0.0] - 2020-01-01
+	// #### Changed
+	// - everything
+	//
+	// <details>
+	// <summary>1.x</summary>
+	//
+	// ### [1.4.1] - 2019-09-01 - Fixes [YANKED]
+	//
+	// ### [1.4.0] - 2019-08-01
+	//
+	// ### [1.3.0] - 2019-05-01 - Old
+	//
+	// </details>
+}
+
+func TestFprintGroupedChangeLog(t *testing.T) {
+	cfg := version.NewConfig(version.WithRenderOptions(version.RenderOptions{
+		Width: 40, Rule: '-', Indent: 2,
+	}))
+	b := strings.Builder{}
+	if err := cfg.FprintGroupedChangeLog(&b, seriesLog); nil != err {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"2.1.x\n\n-----", "version 2.1.0", "\n1.x\n\n",
+		"  1.4.1  2019-09-01  Fixes [YANKED]\n  1.4.0  2019-08-01\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "version 1.4.0") {
+		t.Errorf("collapsed series was expanded:\n%s", out)
+	}
+	bad := []version.Change{{Version: "1.02.0"}}
+	if err := cfg.FprintGroupedChangeLog(&b, bad); nil == err {
+		t.Error("invalid version not reported")
+	}
+}
@@ -0,0 +1,102 @@
+package version
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Store persists a changelog, so that applications can save entries edited at
+// runtime (e.g., by an administrative interface) through one abstraction.
+type Store interface {
+	Load() ([]Change, error)
+	Save(log []Change) error
+}
+
+// FileStore is a Store persisting a changelog to a file, encoded by Write and
+// decoded by Read. Use NewFileStore to select the encoding by file name.
+type FileStore struct {
+	Path  string
+	Read  func(io.Reader) ([]Change, error) // nil if the format is write-only
+	Write func(io.Writer, []Change) error   // nil if the format is read-only
+}
+
+// NewFileStore returns a FileStore for the file at the given path, encoded in a
+// format selected by its name: Markdown (see ReadMarkdown and WriteMarkdown) if
+// it ends with ".md" or ".markdown", CSV (see WriteCSV, write-only) if it ends
+// with ".csv", and JSON (see ReadChangeLog and WriteChangeLog) otherwise.
+//
+// Only JSON preserves every field of each entry. Markdown is lossy: it holds the
+// version, date, title, description, and yanked marker, but not Package, Module,
+// Breaking, Authors, Links, Artifacts, or Template; description lines are
+// grouped by category, and Deprecations are loaded as "Deprecated:" lines. Use
+// a Markdown store only for changelogs that are edited by hand.
+func NewFileStore(path string) *FileStore {
+	s := &FileStore{Path: path, Read: ReadChangeLog, Write: WriteChangeLog}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		s.Read, s.Write = ReadMarkdown, WriteMarkdown
+	case ".csv":
+		s.Read, s.Write = nil, WriteCSV
+	}
+	return s
+}
+
+// Load reads and decodes the changelog file.
+// Returns an error if the file cannot be read or decoded, or if the format is
+// write-only.
+func (s *FileStore) Load() ([]Change, error) {
+	if nil == s.Read {
+		return nil, fmt.Errorf("%s: changelog format is write-only", s.Path)
+	}
+	f, err := os.Open(s.Path)
+	if nil != err {
+		return nil, err
+	}
+	defer f.Close()
+	log, err := s.Read(f)
+	if nil != err {
+		return nil, fmt.Errorf("%s: %v", s.Path, err)
+	}
+	return log, nil
+}
+
+// Save encodes the given changelog and writes it to the file, replacing its
+// previous content. The file is replaced atomically where the operating system
+// supports it, so that readers (e.g., a Watcher) never observe a partially
+// written file.
+// Returns an error if the changelog cannot be encoded or written, or if the
+// format is read-only.
+func (s *FileStore) Save(log []Change) error {
+	if nil == s.Write {
+		return fmt.Errorf("%s: changelog format is read-only", s.Path)
+	}
+	var b bytes.Buffer
+	if err := s.Write(&b, log); nil != err {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), "."+filepath.Base(s.Path)+".*")
+	if nil != err {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no effect once renamed
+	if _, err := tmp.Write(b.Bytes()); nil != err {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); nil != err {
+		return err
+	}
+	mode := os.FileMode(0644)
+	if fi, err := os.Stat(s.Path); nil == err {
+		mode = fi.Mode().Perm()
+	}
+	if err := os.Chmod(tmp.Name(), mode); nil != err {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
@@ -0,0 +1,69 @@
+package version_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	log := []version.Change{
+		{Version: "1.0.0", Date: "2020-03-09", Title: "First"},
+		{Version: "1.1.0", Date: "2020-04-01", Description: []string{"Added: things"}},
+	}
+	for _, name := range []string{"CHANGELOG.json", "CHANGELOG.md"} {
+		var s version.Store = version.NewFileStore(filepath.Join(dir, name))
+		if err := s.Save(log); nil != err {
+			t.Fatalf("%s: Save: %v", name, err)
+		}
+		got, err := s.Load()
+		if nil != err {
+			t.Fatalf("%s: Load: %v", name, err)
+		}
+		if !reflect.DeepEqual(got, log) {
+			t.Errorf("%s: Load = %+v, want %+v", name, got, log)
+		}
+	}
+
+	// Markdown holds only some of the fields of each entry
+	full := []version.Change{{
+		Package: "app", Module: "app/sub", Version: "1.0.0", Date: "2020-03-09",
+		Title: "First", Breaking: true, Yanked: true, Template: "plain",
+		Authors: []string{"Ann"}, Links: []string{"#1"},
+		Artifacts:    []version.Artifact{{Name: "app.tar.gz"}},
+		Deprecations: []version.Deprecation{{Feature: "Old"}},
+		Description:  []string{"Fixed: a", "Added: b"},
+	}}
+	lossy := []version.Change{{
+		Version: "1.0.0", Date: "2020-03-09", Title: "First", Yanked: true,
+		Description: []string{"Added: b", "Deprecated: Old", "Fixed: a"},
+	}}
+	md := version.NewFileStore(filepath.Join(dir, "CHANGELOG.md"))
+	if err := md.Save(full); nil != err {
+		t.Fatal(err)
+	}
+	if got, err := md.Load(); nil != err || !reflect.DeepEqual(got, lossy) {
+		t.Errorf("Markdown round trip = %+v, %v, want %+v", got, err, lossy)
+	}
+
+	csv := version.NewFileStore(filepath.Join(dir, "releases.csv"))
+	if err := csv.Save(log); nil != err {
+		t.Fatal(err)
+	}
+	if _, err := csv.Load(); nil == err {
+		t.Error("Load of write-only format did not return an error")
+	}
+	if files, _ := ioutil.ReadDir(dir); 3 != len(files) {
+		t.Errorf("found %d files, want 3 (temporary file not removed?)", len(files))
+	}
+}
@@ -0,0 +1,83 @@
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ChangeLogReader decodes Change entries one at a time from an io.Reader,
+// so that very large or piped changelogs need not be held in memory at once.
+// The input is either a JSON array of entries, as written by WriteChangeLog,
+// or a stream of JSON objects, such as one entry per line.
+type ChangeLogReader struct {
+	dec   *json.Decoder
+	start bool // the first token has been read
+	array bool // the entries are enclosed in a JSON array
+	err   error
+}
+
+// NewChangeLogReader returns a ChangeLogReader that decodes entries from r.
+func NewChangeLogReader(r io.Reader) *ChangeLogReader {
+	return &ChangeLogReader{dec: json.NewDecoder(r)}
+}
+
+// Next decodes and returns the next entry. It returns io.EOF once every entry
+// has been read, and any other error if the input is malformed, after which
+// every call returns the same error.
+func (cr *ChangeLogReader) Next() (Change, error) {
+	if nil != cr.err {
+		return Change{}, cr.err
+	}
+	var c Change
+	cr.err = cr.next(&c)
+	if nil != cr.err {
+		return Change{}, cr.err
+	}
+	return c, nil
+}
+
+func (cr *ChangeLogReader) next(c *Change) error {
+	if !cr.start {
+		cr.start = true
+		if !cr.dec.More() {
+			return io.EOF
+		}
+		// a JSON array begins with '['; anything else is decoded as an object
+		if d, ok := peek(cr.dec); ok && '[' == d {
+			if _, err := cr.dec.Token(); nil != err {
+				return err
+			}
+			cr.array = true
+		}
+	}
+	if !cr.dec.More() {
+		if cr.array {
+			if _, err := cr.dec.Token(); nil != err { // closing ']'
+				return err
+			}
+			if _, err := cr.dec.Token(); io.EOF != err {
+				return fmt.Errorf("unexpected data after changelog array")
+			}
+		}
+		return io.EOF
+	}
+	return cr.dec.Decode(c)
+}
+
+// peek returns the next non-space byte buffered by dec, without consuming it.
+// It must be called after dec.More, which ensures that byte is buffered.
+func peek(dec *json.Decoder) (byte, bool) {
+	r := dec.Buffered()
+	b := []byte{0}
+	for {
+		if _, err := r.Read(b); nil != err {
+			return 0, false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b[0], true
+	}
+}
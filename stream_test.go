@@ -0,0 +1,66 @@
+package version_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleChangeLogReader() {
+	r := version.NewChangeLogReader(strings.NewReader(`
+		{"Version": "0.1.0", "Title": "First"}
+		{"Version": "0.2.0", "Title": "Second"}
+	`))
+	for {
+		c, err := r.Next()
+		if nil != err {
+			if io.EOF != err {
+				fmt.Println(err)
+			}
+			break
+		}
+		fmt.Println(c.Version, c.Title)
+	}
+	// Output:
+	// 0.1.0 First
+	// 0.2.0 Second
+}
+
+func TestChangeLogReader(t *testing.T) {
+	read := func(s string) ([]string, error) {
+		var list []string
+		r := version.NewChangeLogReader(strings.NewReader(s))
+		for {
+			c, err := r.Next()
+			if io.EOF == err {
+				return list, nil
+			}
+			if nil != err {
+				if _, again := r.Next(); again != err {
+					t.Errorf("Next() after error = %v, want %v", again, err)
+				}
+				return list, err
+			}
+			list = append(list, c.Version)
+		}
+	}
+	for in, want := range map[string]string{
+		``:      "",
+		` [ ] `: "",
+		`[{"Version":"1.0.0"},{"Version":"1.1.0"}]`: "1.0.0 1.1.0",
+		`{"Version":"1.0.0"}{"Version":"1.1.0"}`:    "1.0.0 1.1.0",
+	} {
+		got, err := read(in)
+		if nil != err || strings.Join(got, " ") != want {
+			t.Errorf("read(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{`[{"Version":"1.0.0"}`, `[{"Version":1}]`, `[] []`, `{`} {
+		if _, err := read(in); nil == err {
+			t.Errorf("read(%q): expected error", in)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package version
+
+import "strings"
+
+// BumpRules maps categories of changes to the part of the version (see Bump)
+// incremented by a release containing them, as suggested by SuggestBump. The
+// category "Breaking" applies to each change identified by IsBreaking; other
+// categories, including those not written by WriteMarkdown (e.g., "Perf"),
+// apply to each change with a description line prefixed by that category and a
+// colon (e.g., "Added: new feature").
+var BumpRules = map[string]string{
+	"Breaking":   "major",
+	"Security":   "major",
+	"Removed":    "major",
+	"Added":      "minor",
+	"Changed":    "minor",
+	"Deprecated": "minor",
+	"Fixed":      "patch",
+}
+
+// bumpRank orders the parts of a semantic version by significance. Other parts
+// (such as those of a custom Scheme) rank below "patch".
+var bumpRank = map[string]int{"major": 3, "minor": 2, "patch": 1}
+
+// SuggestBump returns the most significant part of the version ("major",
+// "minor", or "patch") incremented by a release of the given changes, such as
+// the entries not yet released, according to the categories of each change
+// mapped by BumpRules. Returns an empty string if no change belongs to a mapped
+// category.
+func SuggestBump(changes []Change) string {
+	best, rank := "", -1
+	suggest := func(category string) {
+		if part, ok := BumpRules[category]; ok && bumpRank[part] > rank {
+			best, rank = part, bumpRank[part]
+		}
+	}
+	for i := range changes {
+		c := &changes[i]
+		if c.IsBreaking() {
+			suggest("Breaking")
+		}
+		for _, line := range c.Description {
+			if i := strings.Index(line, ": "); i > 0 {
+				suggest(line[:i])
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,48 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleSuggestBump() {
+	unreleased := []version.Change{
+		{Description: []string{"Fixed: crash on startup"}},
+		{Description: []string{"Added: support for widgets", "update docs"}},
+	}
+	part := version.SuggestBump(unreleased)
+	next, _ := version.Bump("1.4.2", part)
+	fmt.Println(part, next)
+	// Output:
+	// minor 1.5.0
+}
+
+func TestSuggestBump(t *testing.T) {
+	for _, tc := range []struct {
+		changes []version.Change
+		want    string
+	}{
+		{[]version.Change{{Description: []string{"update docs"}}}, ""},
+		{[]version.Change{{Description: []string{"Fixed: x"}}}, "patch"},
+		{[]version.Change{{Description: []string{"Fixed: x", "Security: y"}}}, "major"},
+		{[]version.Change{{Description: []string{"Fixed: x"}}, {Description: []string{"Added: y"}}}, "minor"},
+		{[]version.Change{{Breaking: true}}, "major"},
+	} {
+		if got := version.SuggestBump(tc.changes); got != tc.want {
+			t.Errorf("SuggestBump(%v) = %q, want %q", tc.changes, got, tc.want)
+		}
+	}
+
+	defer func(m map[string]string) { version.BumpRules = m }(version.BumpRules)
+	version.BumpRules = map[string]string{"Breaking": "minor", "Fixed": "patch", "Perf": "minor"}
+	breaking := []version.Change{{Description: []string{"BREAKING: x", "Fixed: y", "Added: z"}}}
+	if got := version.SuggestBump(breaking); "minor" != got {
+		t.Errorf("SuggestBump(custom rules) = %q, want minor", got)
+	}
+	perf := []version.Change{{Description: []string{"Fixed: x", "Perf: faster y"}}}
+	if got := version.SuggestBump(perf); "minor" != got {
+		t.Errorf("SuggestBump(custom category) = %q, want minor", got)
+	}
+}
@@ -0,0 +1,55 @@
+package version
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// FprintChangeLogSummary writes to given io.Writer w an aligned table with one
+// line per entry in ChangeLog, listing its version, date, and title, as a
+// compact alternative to FprintChangeLog. Entries marked Yanked are flagged.
+// Returns any error encountered writing to w.
+func FprintChangeLogSummary(w io.Writer) error {
+	return writeSummary(w, ChangeLog, 0, true)
+}
+
+// PrintChangeLogSummary writes to stdout an aligned table with one line per
+// entry in ChangeLog (see FprintChangeLogSummary).
+// Returns any error encountered writing to stdout.
+func PrintChangeLogSummary() error {
+	return FprintChangeLogSummary(os.Stdout)
+}
+
+// writeSummary writes to w an aligned table with one line per Change, each
+// indented by the given number of spaces, preceded by a row of column headings
+// if header is true. Dates are written as YYYY-MM-DD where recognized.
+func writeSummary(w io.Writer, log []Change, indent int, header bool) error {
+	b := strings.Builder{}
+	tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	if header {
+		fmt.Fprintf(tw, "%*sVERSION\tDATE\tTITLE\n", indent, "")
+	}
+	for _, c := range log {
+		date := c.Date
+		if t := ParseDate(c.Date); nil != t {
+			date = t.Format("2006-01-02")
+		}
+		title := c.Title
+		if c.Yanked {
+			title = strings.TrimSpace(title + " [YANKED]")
+		}
+		fmt.Fprintf(tw, "%*s%s\t%s\t%s\n", indent, "", c.Version, date, title)
+	}
+	tw.Flush()
+	for _, line := range strings.SplitAfter(b.String(), "\n") {
+		if "" != line {
+			if _, err := fmt.Fprintln(w, strings.TrimRight(line, " \n")); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
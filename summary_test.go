@@ -0,0 +1,16 @@
+package version_test
+
+import (
+	"os"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleFprintChangeLogSummary() {
+	version.FprintChangeLogSummary(os.Stdout)
+	// Output:
+	// VERSION         DATE        TITLE
+	// 0.1.0           2020-02-26
+	// 0.1.0+fqt                   Formal Test
+	// 0.2.0-beta+red  2020-03-09  Red Label
+}
@@ -0,0 +1,41 @@
+package version
+
+// minSupported is the minimum supported version declared by MinSupported.
+var minSupported string
+
+// MinSupported declares the given version as the oldest version that is still
+// supported (e.g., receives fixes). Entries in ChangeLog with lower precedence
+// are marked "[UNSUPPORTED]" when rendered. An empty version removes the
+// declaration, so all versions are supported.
+// Returns an error if the given version string is invalid.
+func MinSupported(version string) error {
+	if "" != version {
+		if err := validate(version); nil != err {
+			return err
+		}
+	}
+	minSupported = version
+	return nil
+}
+
+// IsSupported returns true if and only if the given version string is valid and
+// no minimum supported version has been declared or the given version has
+// equal or higher precedence than it.
+func IsSupported(version string) bool {
+	if nil != validate(version) {
+		return false
+	}
+	return "" == minSupported || compareVersions(version, minSupported) >= 0
+}
+
+// SupportWindow returns each entry in ChangeLog that is supported (see
+// IsSupported) and not marked Yanked, in ChangeLog order.
+func SupportWindow() []Change {
+	var changes []Change
+	for _, c := range ChangeLog {
+		if !c.Yanked && IsSupported(c.Version) {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
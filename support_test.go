@@ -0,0 +1,38 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestMinSupported(t *testing.T) {
+	defer version.MinSupported("")
+	defer func(log []version.Change) { version.ChangeLog = log }(version.ChangeLog)
+	version.ChangeLog = []version.Change{
+		{Version: "1.3.0"}, {Version: "1.4.0", Yanked: true}, {Version: "1.5.0"},
+	}
+	if !version.IsSupported("1.0.0") {
+		t.Errorf("IsSupported(1.0.0) = false without MinSupported")
+	}
+	if err := version.MinSupported("1.4"); nil == err {
+		t.Errorf("MinSupported(invalid) = nil error")
+	}
+	if err := version.MinSupported("1.4.0"); nil != err {
+		t.Fatal(err)
+	}
+	if version.IsSupported("1.3.9") || !version.IsSupported("1.4.0") || version.IsSupported("bogus") {
+		t.Errorf("IsSupported reported incorrectly")
+	}
+	if w := version.SupportWindow(); 1 != len(w) || "1.5.0" != w[0].Version {
+		t.Errorf("SupportWindow() = %v", w)
+	}
+	opts := version.RenderOptions{Width: 40}
+	if s := version.ChangeLog[0].Layout(opts); !strings.Contains(s, "[UNSUPPORTED]") {
+		t.Errorf("Layout(1.3.0) = %q, want [UNSUPPORTED]", s)
+	}
+	if s := version.ChangeLog[2].Layout(opts); strings.Contains(s, "[UNSUPPORTED]") {
+		t.Errorf("Layout(1.5.0) = %q, want supported", s)
+	}
+}
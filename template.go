@@ -0,0 +1,189 @@
+package version
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// ChangeTemplate, if defined, is used by Change.String (and thus FprintChangeLog
+// and PrintChangeLog) to format each Change instead of the default layout.
+// The template is executed with the Change as its data, and it may call any of
+// the functions provided by TemplateFuncs. Use NewTemplate to construct it.
+//
+// A Change may be formatted by another template defined in ChangeTemplate
+// (e.g., with {{define "security"}}), selected by its Template field or, if
+// that is empty, by CategoryTemplates.
+var ChangeTemplate *template.Template
+
+// CategoryTemplates maps categories of changes to the name of the template, in
+// the template set used to format them (e.g., ChangeTemplate), used to format
+// each Change that does not select a template of its own. A Change belongs to
+// "Breaking" if it is marked Breaking, "Yanked" if it is marked Yanked, and to
+// each category prefixing its description lines (e.g., "Security" for a line
+// "Security: fix overflow"), considered in that order; the first category
+// mapped to a template is used.
+var CategoryTemplates map[string]string
+
+// templateName returns the name of the template selected by Change c, or an
+// empty string if c is formatted by the template itself.
+func (c *Change) templateName() string {
+	if "" != c.Template {
+		return c.Template
+	}
+	if 0 == len(CategoryTemplates) {
+		return ""
+	}
+	cats := make([]string, 0, len(c.Description)+2)
+	if c.Breaking {
+		cats = append(cats, "Breaking")
+	}
+	if c.Yanked {
+		cats = append(cats, "Yanked")
+	}
+	for _, line := range c.Description {
+		if cat, _ := splitCategory(line); "" != cat {
+			cats = append(cats, cat)
+		}
+	}
+	for _, cat := range cats {
+		if name, ok := CategoryTemplates[cat]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// templateFuncs contains the functions registered with RegisterTemplateFunc.
+var templateFuncs = struct {
+	sync.RWMutex
+	m template.FuncMap
+}{m: template.FuncMap{}}
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// RegisterTemplateFunc adds function fn, with the given name, to those provided
+// by TemplateFuncs to templates subsequently created with NewTemplate, such as
+// a function mapping categories to emoji. It replaces any function previously
+// registered or predefined with the same name.
+// It is safe to call RegisterTemplateFunc from multiple goroutines.
+// Returns an error if name is not a valid identifier or fn is not a function
+// returning either one value or a value and an error, as required by
+// text/template.
+func RegisterTemplateFunc(name string, fn interface{}) error {
+	if "" == name {
+		return errors.New("register template function: empty name")
+	}
+	t := reflect.TypeOf(fn)
+	if nil == t || reflect.Func != t.Kind() {
+		return fmt.Errorf("register template function %s: not a function", name)
+	}
+	if n := t.NumOut(); n < 1 || n > 2 || (2 == n && errorType != t.Out(1)) {
+		return fmt.Errorf("register template function %s: "+
+			"must return one value, or a value and an error", name)
+	}
+	if err := checkTemplateFunc(name, fn); nil != err {
+		return fmt.Errorf("register template function %s: %v", name, err)
+	}
+	templateFuncs.Lock()
+	defer templateFuncs.Unlock()
+	templateFuncs.m[name] = fn
+	return nil
+}
+
+// UnregisterTemplateFunc removes the function with the given name from those
+// registered with RegisterTemplateFunc. Templates already created with
+// NewTemplate are not affected.
+func UnregisterTemplateFunc(name string) {
+	templateFuncs.Lock()
+	defer templateFuncs.Unlock()
+	delete(templateFuncs.m, name)
+}
+
+// checkTemplateFunc returns the reason, if any, that text/template would panic
+// when given function fn with the given name.
+func checkTemplateFunc(name string, fn interface{}) (err error) {
+	defer func() {
+		if r := recover(); nil != r {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	template.New("").Funcs(template.FuncMap{name: fn})
+	return nil
+}
+
+// TemplateFuncs returns the helper functions available to templates created
+// with NewTemplate:
+//
+//	date LAYOUT STRING   format the date-time STRING recognized by ParseDate using
+//	                     time.Format LAYOUT, or "" if unrecognized
+//	datetime             the current value of DateTimeFormat
+//	semver STRING        parse the version STRING into a Semver
+//	join LIST SEP        concatenate LIST of strings separated by SEP
+//	repeat STRING N      concatenate N copies of STRING
+//	upper STRING         convert STRING to upper case
+//	lower STRING         convert STRING to lower case
+//	quote STRING         double-quote STRING with Go escapes
+//	json VALUE           encode VALUE as JSON
+//	category LINE        the category prefixing description LINE (e.g., "Added"),
+//	                     or "" if it has none
+//	item LINE            description LINE without its category prefix
+//
+// followed by each function registered with RegisterTemplateFunc.
+func TemplateFuncs() template.FuncMap {
+	funcs := template.FuncMap{
+		"date": func(layout, s string) string {
+			if t := ParseDate(s); nil != t {
+				return t.Format(layout)
+			}
+			return ""
+		},
+		"datetime": func() string { return DateTimeFormat },
+		"semver":   ParseSemver,
+		"join":     strings.Join,
+		"repeat":   strings.Repeat,
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"quote":    func(s string) string { return fmt.Sprintf("%q", s) },
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"category": func(line string) string {
+			cat, _ := splitCategory(line)
+			return cat
+		},
+		"item": func(line string) string {
+			_, text := splitCategory(line)
+			return text
+		},
+	}
+	templateFuncs.RLock()
+	defer templateFuncs.RUnlock()
+	for name, fn := range templateFuncs.m {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// NewTemplate parses the given text as a template for formatting a Change,
+// with the functions provided by TemplateFuncs.
+func NewTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(TemplateFuncs()).Parse(text)
+}
+
+// Execute writes to given io.Writer w the Change c formatted by template t, or
+// by the template associated with t that c selects (see ChangeTemplate).
+// Returns an error if the selected template is not defined or fails.
+func (c *Change) Execute(w io.Writer, t *template.Template) error {
+	if name := c.templateName(); "" != name {
+		return t.ExecuteTemplate(w, name, c)
+	}
+	return t.Execute(w, c)
+}
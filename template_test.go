@@ -0,0 +1,95 @@
+package version_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleNewTemplate() {
+	t, err := version.NewTemplate("change", `## {{.Version}}
+{{- with .Title}} {{quote .}}{{end}}
+{{- with date "2006-01-02" .Date}} ({{.}}){{end}}
+{{range .Description}}* {{.}}
+{{end}}`)
+	if nil != err {
+		panic(err)
+	}
+	c := version.Change{
+		Version:     "0.2.0-beta+red",
+		Title:       "Red Label",
+		Date:        "20-March-9 17:45:23",
+		Description: []string{"add feature: Dude", "fix bug: Sweet"},
+	}
+	c.Execute(os.Stdout, t)
+
+	// Output:
+	// ## 0.2.0-beta+red "Red Label" (2020-03-09)
+	// * add feature: Dude
+	// * fix bug: Sweet
+}
+
+func ExampleCategoryTemplates() {
+	defer func(m map[string]string) { version.CategoryTemplates = m }(version.CategoryTemplates)
+	version.CategoryTemplates = map[string]string{"Security": "security"}
+
+	emoji := map[string]string{"Added": "✨", "Fixed": "🐛", "Security": "🔒"}
+	version.RegisterTemplateFunc("emoji", func(cat string) string { return emoji[cat] })
+	defer version.UnregisterTemplateFunc("emoji")
+
+	t, err := version.NewTemplate("change", `{{.Version}}
+{{range .Description}}  {{emoji (category .)}} {{item .}}
+{{end}}
+{{- define "security"}}{{.Version}} (SECURITY RELEASE)
+{{range .Description}}  {{emoji (category .)}} {{item .}}
+{{end}}{{end}}
+{{- define "plain"}}{{.Version}}: {{join .Description "; "}}
+{{end}}`)
+	if nil != err {
+		panic(err)
+	}
+	for _, c := range []version.Change{
+		{Version: "1.0.0", Description: []string{"Added: widgets", "Fixed: crash"}},
+		{Version: "1.0.1", Description: []string{"Security: patch overflow"}},
+		{Version: "1.0.2", Description: []string{"docs", "tests"}, Template: "plain"},
+	} {
+		c.Execute(os.Stdout, t)
+	}
+
+	// Output:
+	// 1.0.0
+	//   ✨ widgets
+	//   🐛 crash
+	// 1.0.1 (SECURITY RELEASE)
+	//   🔒 patch overflow
+	// 1.0.2: docs; tests
+}
+
+func TestRegisterTemplateFunc(t *testing.T) {
+	for name, fn := range map[string]interface{}{
+		"":      func() string { return "" },
+		"notfn": "string",
+		"none":  func() {},
+		"bad":   func() (string, string) { return "", "" },
+		"a-b":   func() string { return "" },
+		"1st":   func() string { return "" },
+	} {
+		if err := version.RegisterTemplateFunc(name, fn); nil == err {
+			t.Errorf("RegisterTemplateFunc(%q): expected error", name)
+		}
+	}
+	if err := version.RegisterTemplateFunc("scratch", strings.TrimSpace); nil != err {
+		t.Fatalf("RegisterTemplateFunc(scratch): %v", err)
+	}
+	version.UnregisterTemplateFunc("scratch")
+	if _, ok := version.TemplateFuncs()["scratch"]; ok {
+		t.Error("UnregisterTemplateFunc(scratch): still registered")
+	}
+	c := version.Change{Version: "1.0.0", Template: "missing"}
+	tmpl, _ := version.NewTemplate("change", "{{.Version}}")
+	if err := c.Execute(os.Stdout, tmpl); nil == err {
+		t.Error("Execute(undefined template): expected error")
+	}
+}
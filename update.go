@@ -0,0 +1,110 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Update describes the result of CheckLatest.
+type Update struct {
+	Current   string   // version of the running package (see String)
+	Latest    string   // latest released version
+	Available bool     // Latest has higher precedence than Current
+	Changes   []Change // released entries newer than Current, oldest first
+}
+
+// CheckLatest fetches the released versions of the package from source and
+// reports whether a newer version than the running version (see String) is
+// available, along with the entries for each newer release. The source is
+// either:
+//
+//	owner/repo    a GitHub repository, whose releases are retrieved with GitHub
+//	https://...   a URL serving JSON: either an array of Change entries (as
+//	              written by WriteChangeLog) or an object of the form
+//	              {"version": "1.2.3", "changelog": [...]}
+//
+// Yanked versions are never reported, and prereleases are only reported if the
+// running version is itself a prerelease. Returns an error if the running
+// version is undefined or invalid, or the source cannot be retrieved.
+func CheckLatest(ctx context.Context, source string) (*Update, error) {
+	cur, err := versionString()
+	if nil != err {
+		return nil, fmt.Errorf("check latest: %w", err)
+	}
+	if "" == cur {
+		return nil, errors.New("check latest: package version not set")
+	}
+	if !IsValid(cur) {
+		return nil, fmt.Errorf("check latest: invalid version: %s", cur)
+	}
+	_, _, _, curPre, _ := Parse(cur)
+
+	var log []Change
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		log, err = fetchChangeLog(ctx, source)
+	} else if p := strings.Split(source, "/"); 2 == len(p) && "" != p[0] && "" != p[1] {
+		log, err = (&GitHub{Owner: p[0], Repo: p[1]}).Releases(ctx)
+	} else {
+		err = fmt.Errorf("unrecognized source %q", source)
+	}
+	if nil != err {
+		return nil, fmt.Errorf("check latest: %w", err)
+	}
+
+	u := &Update{Current: cur, Latest: cur}
+	for _, c := range log {
+		if c.Yanked || !IsValid(c.Version) {
+			continue
+		}
+		if _, _, _, pre, _ := Parse(c.Version); "" != pre && "" == curPre {
+			continue
+		}
+		if Compare(c.Version, cur) > 0 {
+			u.Changes = append(u.Changes, c)
+			if Compare(c.Version, u.Latest) > 0 {
+				u.Latest = c.Version
+			}
+		}
+	}
+	sort.SliceStable(u.Changes, func(i, j int) bool {
+		return Compare(u.Changes[i].Version, u.Changes[j].Version) < 0
+	})
+	u.Available = len(u.Changes) > 0
+	return u, nil
+}
+
+// fetchChangeLog retrieves the released versions served as JSON at the given
+// URL, as described by CheckLatest.
+func fetchChangeLog(ctx context.Context, url string) ([]Change, error) {
+	var raw json.RawMessage
+	header := http.Header{"Accept": {"application/json"}}
+	if err := doJSON(ctx, nil, http.MethodGet, url, header, nil, &raw); nil != err {
+		return nil, err
+	}
+	var log []Change
+	if err := json.Unmarshal(raw, &log); nil == err {
+		return log, nil
+	}
+	var obj struct {
+		Version   string   `json:"version"`
+		ChangeLog []Change `json:"changelog"`
+	}
+	if err := json.Unmarshal(raw, &obj); nil != err {
+		return nil, err
+	}
+	if "" != obj.Version {
+		found := false
+		for _, c := range obj.ChangeLog {
+			found = found || c.Version == obj.Version
+		}
+		if !found {
+			obj.ChangeLog = append(obj.ChangeLog, Change{Version: obj.Version})
+		}
+	}
+	return obj.ChangeLog, nil
+}
@@ -0,0 +1,75 @@
+package version_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCheckLatest(t *testing.T) {
+	ver, log := version.Version, version.ChangeLog
+	defer func() { version.Version, version.ChangeLog = ver, log }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/changelog.json":
+			fmt.Fprint(w, `[
+				{"version": "1.0.0"},
+				{"version": "1.1.0", "description": ["add Dude"]},
+				{"version": "1.2.0", "yanked": true},
+				{"version": "2.0.0-rc.1"}
+			]`)
+		case "/unordered.json":
+			fmt.Fprint(w, `[{"version": "1.2.1"}, {"version": "1.0.1"}, {"version": "1.1.0"}]`)
+		case "/latest.json":
+			fmt.Fprint(w, `{"version": "1.3.0"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	version.ChangeLog = nil
+	version.Set("1.0.0")
+	ctx := context.Background()
+
+	u, err := version.CheckLatest(ctx, srv.URL+"/changelog.json")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !u.Available || "1.1.0" != u.Latest || 1 != len(u.Changes) {
+		t.Errorf("CheckLatest(changelog) = %+v", u)
+	}
+
+	u, err = version.CheckLatest(ctx, srv.URL+"/unordered.json")
+	if nil != err || "1.2.1" != u.Latest || 3 != len(u.Changes) ||
+		"1.0.1" != u.Changes[0].Version || "1.2.1" != u.Changes[2].Version {
+		t.Errorf("CheckLatest(unordered) = %+v, %v, want changes oldest first", u, err)
+	}
+
+	u, err = version.CheckLatest(ctx, srv.URL+"/latest.json")
+	if nil != err || !u.Available || "1.3.0" != u.Latest {
+		t.Errorf("CheckLatest(latest) = %+v, %v", u, err)
+	}
+
+	version.Set("1.3.0")
+	if u, err = version.CheckLatest(ctx, srv.URL+"/latest.json"); nil != err || u.Available {
+		t.Errorf("CheckLatest(latest) = %+v, %v, want no update", u, err)
+	}
+
+	if _, err := version.CheckLatest(ctx, srv.URL+"/missing"); nil == err {
+		t.Error("CheckLatest(missing) expected error")
+	}
+	if _, err := version.CheckLatest(ctx, "not a source"); nil == err {
+		t.Error("CheckLatest(not a source) expected error")
+	}
+
+	version.Version, version.ChangeLog = version.Semver{}, version.History{{Version: "bogus"}}
+	if _, err := version.CheckLatest(ctx, srv.URL+"/changelog.json"); nil == err {
+		t.Error("CheckLatest(invalid ChangeLog) expected error")
+	}
+}
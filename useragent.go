@@ -0,0 +1,69 @@
+package version
+
+import (
+	"runtime"
+	"strings"
+)
+
+// UserAgent returns a User-Agent header value for HTTP clients in the form
+// defined by RFC 7231, section 5.5.3, composed of the given product name, the
+// package version, and a comment describing the platform, Go version, and
+// Commit (if defined):
+//
+//	myapp/1.2.3 (linux/amd64; go1.14; abc1234)
+//
+// If product is empty, the package name of the last ChangeLog entry without a
+// Module is used.
+// Characters not permitted in a product token are replaced with "-". The
+// version is omitted if it is undefined or invalid.
+func UserAgent(product string) string {
+	if c := Default.latest(); "" == product && nil != c {
+		product = c.Package
+	}
+	b := strings.Builder{}
+	b.WriteString(token(product))
+	if ver, err := versionString(); nil == err && "" != ver {
+		b.WriteRune('/')
+		b.WriteString(token(ver))
+	}
+	comment := []string{runtime.GOOS + "/" + runtime.GOARCH, runtime.Version()}
+	if "" != Commit {
+		comment = append(comment, Commit)
+	}
+	b.WriteString(" (")
+	b.WriteString(commentText(strings.Join(comment, "; ")))
+	b.WriteRune(')')
+	return b.String()
+}
+
+// token returns s with each character not permitted in an RFC 7230 token
+// replaced with "-". An empty string is replaced with "Go".
+func token(s string) string {
+	if "" == s {
+		return "Go"
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' ||
+			strings.ContainsRune("!#$%&'*+-.^_`|~", r) {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
+// commentText returns s with the characters "(", ")", and "\" escaped, and
+// control characters removed, for use in an RFC 7230 comment.
+func commentText(s string) string {
+	b := strings.Builder{}
+	for _, r := range s {
+		switch {
+		case '(' == r, ')' == r, '\\' == r:
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < ' ', 0x7f == r:
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
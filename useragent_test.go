@@ -0,0 +1,27 @@
+package version_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestUserAgent(t *testing.T) {
+	defer func(v version.Semver, commit string) {
+		version.Version, version.Commit = v, commit
+	}(version.Version, version.Commit)
+	version.Set("1.2.3+build.7")
+	version.Commit = "abc(1234)"
+
+	platform := runtime.GOOS + "/" + runtime.GOARCH + "; " + runtime.Version()
+	for product, want := range map[string]string{
+		"myapp":  "myapp/1.2.3+build.7 (" + platform + `; abc\(1234\))`,
+		"my app": "my-app/1.2.3+build.7 (" + platform + `; abc\(1234\))`,
+		"":       "mypkg/1.2.3+build.7 (" + platform + `; abc\(1234\))`,
+	} {
+		if got := version.UserAgent(product); got != want {
+			t.Errorf("UserAgent(%q) = %q, want %q", product, got, want)
+		}
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Version is the current version of the package. Use Set() or define ChangeLog
-// to set the version.
-var Version struct {
+// Semver represents the components of a semantic version.
+type Semver struct {
 	Major      uint
 	Minor      uint
 	Patch      uint
@@ -19,288 +20,424 @@ var Version struct {
 	Metadata   string
 }
 
+// String returns the semantic version string composed of the components of v.
+func (v Semver) String() string {
+	return format(v.Major, v.Minor, v.Patch, v.Prerelease, v.Metadata)
+}
+
+// Version is the current version of the package. Use Set() or define ChangeLog
+// to set the version.
+var Version Semver
+
 // VersionPattern defines the regular expression used to validate and identify
 // the components of a semantic version string.
 //
 // Source: https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
-var VersionPattern = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+var VersionPattern = semverPattern
+
+// patternCache holds the most recently compiled version pattern, so that the
+// same regular expression is not recompiled by every call to Parse or IsValid.
+// The cache is keyed by the pattern's source, and is therefore invalidated by
+// any change to VersionPattern, including direct assignment.
+var patternCache struct {
+	sync.Mutex
+	source string
+	re     *regexp.Regexp
+}
+
+// compilePattern returns the compiled regular expression of the given pattern,
+// reusing the cached result if the pattern has not changed.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	patternCache.Lock()
+	defer patternCache.Unlock()
+	if nil == patternCache.re || pattern != patternCache.source {
+		re, err := regexp.Compile(pattern)
+		if nil != err {
+			return nil, err
+		}
+		patternCache.source, patternCache.re = pattern, re
+	}
+	return patternCache.re, nil
+}
+
+// mustCompilePattern is like compilePattern but panics if pattern is invalid.
+func mustCompilePattern(pattern string) *regexp.Regexp {
+	re, err := compilePattern(pattern)
+	if nil != err {
+		panic(err)
+	}
+	return re
+}
+
+// SetVersionPattern sets VersionPattern to the given regular expression, and
+// compiles it once for use by every subsequent call to Parse and IsValid.
+// Returns an error, leaving VersionPattern unchanged, if pattern is invalid.
+func SetVersionPattern(pattern string) error {
+	if _, err := compilePattern(pattern); nil != err {
+		return err
+	}
+	VersionPattern = pattern
+	return nil
+}
 
 // See `go doc time.Parse` for formatting convention.
 var (
 	// DateTimeFormat defines the format used to write the date-time of a version
 	// change; the output format string.
 	DateTimeFormat = time.RFC1123
+
+	// DateTimeLocation defines the time zone in which date-times are written.
+	// Date-times without zone information are interpreted in this location, and
+	// all others are converted to it. Use time.Local for the system's local time
+	// zone. If nil, date-times without zone information are interpreted in UTC,
+	// and all others keep their zone.
+	DateTimeLocation *time.Location
 )
 
-// Change represents the details of a version change.
+// Change represents the details of a version change. In a repository containing
+// several independently-versioned modules, Module identifies the module (e.g.,
+// its subdirectory or module path) to which the change applies.
 type Change struct {
-	Package     string
-	Version     string
-	Title       string
-	Date        string
-	Description []string
+	Package     string   `json:"package,omitempty"`
+	Module      string   `json:"module,omitempty"`
+	Version     string   `json:"version"`
+	Title       string   `json:"title,omitempty"`
+	Date        string   `json:"date,omitempty"`
+	Description []string `json:"description,omitempty"`
+
+	// Breaking indicates the change is incompatible with previous versions.
+	// Individual lines of the description may instead be marked as breaking
+	// with a prefix such as "BREAKING CHANGE:" (see IsBreaking).
+	Breaking bool `json:"breaking,omitempty"`
+
+	// Authors lists the names of those who contributed the change.
+	Authors []string `json:"authors,omitempty"`
+
+	// Links lists URLs or issue references (e.g., "#123") related to the change.
+	// See References and IssueURLFormat.
+	Links []string `json:"links,omitempty"`
+
+	// Yanked indicates the version was withdrawn (e.g., due to a severe bug) and
+	// must not be recommended or selected, similar to Go's retract directive.
+	Yanked bool `json:"yanked,omitempty"`
+
+	// Artifacts lists the files distributed with the release.
+	Artifacts []Artifact `json:"artifacts,omitempty"`
+
+	// Deprecations lists the features deprecated by the change, or the version
+	// itself (see Deprecation).
+	Deprecations []Deprecation `json:"deprecations,omitempty"`
+
+	// Template names the template used to format the change, which must be
+	// defined in the template set formatting it (see ChangeTemplate).
+	Template string `json:"template,omitempty"`
 }
 
-// ParseDate parses the given date-time string. It attempts every permutation of
-// each dateFormat and timeFormat pair (in either order), returning the first
-// successfully-parsed time.Time object. If none of the pairs are successful,
-// each dateFormat (ignoring timeFormat) is then attempted. Finally, each of the
-// standard formats provided by the time package are attempted.
-func ParseDate(date string) *time.Time {
-	if "" != date {
-		dateFormat := []string{
-			`2006 January 2`,
-			`2006-January-2`,
-			`2006 Jan 2`,
-			`2006-Jan-2`,
-			`2006-1-2`,
-			`2006 1 2`,
-			`1-2-2006`,
-			`1/2/2006`,
-			`01-02-2006`,
-			`01/02/2006`,
-
-			`January 2, 2006`,
-			`Jan 2, 2006`,
-
-			`06 January 2`,
-			`06-January-2`,
-			`06 Jan 2`,
-			`06-Jan-2`,
-			`06-1-2`,
-			`06 1 2`,
-			`1-2-06`,
-			`1/2/06`,
-			`01-02-06`,
-			`01/02/06`,
-
-			`January 2, 06`,
-			`Jan 2, 06`,
-		}
-		timeFormat := []string{
-			`15:04:05`,
+// String returns a formatted, multi-line string describing Change c, as
+// returned by Render. If c cannot be rendered, such as when its version string
+// is invalid or the template fails, String does not panic; instead, it returns
+// a plain description of c that includes the error.
+func (c *Change) String() string {
+	s, err := c.Render()
+	if nil != err {
+		return c.sketch(err)
+	}
+	return s
+}
 
-			`03:04:05PM`,
-			`03:04:05pm`,
-			`3:04:05PM`,
-			`3:04:05pm`,
+// Render returns a formatted, multi-line string describing Change c.
+// If ChangeTemplate is defined, it is used to format c; otherwise, c is
+// formatted by Layout with DefaultRenderOptions.
+// Returns an error if the version string is invalid or the template fails.
+func (c *Change) Render() (string, error) {
+	return globalConfig().Layout(c)
+}
 
-			`15:04`,
+// sketch returns a plain description of Change c, independent of the validity
+// of its version string, noting the given error encountered rendering c.
+func (c *Change) sketch(err error) string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "version %s", c.Version)
+	if "" != c.Title {
+		fmt.Fprintf(&b, " - %q", c.Title)
+	}
+	if "" != c.Date {
+		fmt.Fprintf(&b, " (%s)", c.Date)
+	}
+	fmt.Fprintf(&b, " [%v]\n", err)
+	for _, line := range c.Description {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+	return b.String()
+}
 
-			`03:04PM`,
-			`03:04pm`,
-			`3:04PM`,
-			`3:04pm`,
-		}
-		for _, fd := range dateFormat {
-			for _, ft := range timeFormat {
-				dt := fmt.Sprintf("%s %s", fd, ft)
-				if t, err := time.Parse(dt, date); nil == err {
-					return &t
-				}
-				td := fmt.Sprintf("%s %s", ft, fd)
-				if t, err := time.Parse(td, date); nil == err {
-					return &t
-				}
-			}
+// ChangeLog contains the history of version changes. It is the default History
+// used by the package-level functions.
+var ChangeLog History
+
+// Parse validates a semantic version string and returns each of its components.
+// It panics if the given version string is invalid, with a *ParseError if
+// VersionPattern has its default value.
+func Parse(version string) (major, minor, patch uint, pre, meta string) {
+	if semverPattern == VersionPattern {
+		v, ok := scanSemver(version)
+		if !ok {
+			panic(diagnose(version))
 		}
-		for _, fd := range dateFormat {
-			if t, err := time.Parse(fd, date); nil == err {
-				return &t
-			}
+		return v.Major, v.Minor, v.Patch, v.Prerelease, v.Metadata
+	}
+	return parsePattern(version)
+}
+
+// parsePattern validates a version string and returns each of its components
+// using the regular expression VersionPattern.
+// It panics if the given version string is invalid.
+func parsePattern(version string) (major, minor, patch uint, pre, meta string) {
+	v, err := parseRegexp(mustCompilePattern(VersionPattern), version)
+	if nil != err {
+		panic(err.Error())
+	}
+	return v.Major, v.Minor, v.Patch, v.Prerelease, v.Metadata
+}
+
+// parseRegexp validates a version string and returns each of its components
+// using the given regular expression, whose capture groups identify, in order,
+// the major, minor, and patch numbers, optionally followed by prerelease and
+// build metadata. A number whose group did not participate in the match is
+// zero. Returns an error if the version string is not matched by re, re has
+// fewer than three capture groups, or a number is invalid.
+func parseRegexp(re *regexp.Regexp, version string) (Semver, error) {
+	if re.NumSubexp() < 3 {
+		return Semver{}, fmt.Errorf("invalid version pattern %q: "+
+			"expected at least 3 capture groups", re)
+	}
+	sub := re.FindStringSubmatch(version)
+	if nil == sub {
+		return Semver{}, fmt.Errorf("invalid version: %s", version)
+	}
+	var v Semver
+	for i, n := range []*uint{&v.Major, &v.Minor, &v.Patch} {
+		if "" == sub[i+1] {
+			continue
 		}
-		for _, dt := range []string{
-			time.ANSIC,       // "Mon Jan _2 15:04:05 2006"
-			time.UnixDate,    // "Mon Jan _2 15:04:05 MST 2006"
-			time.RubyDate,    // "Mon Jan 02 15:04:05 -0700 2006"
-			time.RFC822,      // "02 Jan 06 15:04 MST"
-			time.RFC822Z,     // "02 Jan 06 15:04 -0700" // RFC822 with numeric zone
-			time.RFC850,      // "Monday, 02-Jan-06 15:04:05 MST"
-			time.RFC1123,     // "Mon, 02 Jan 2006 15:04:05 MST"
-			time.RFC1123Z,    // "Mon, 02 Jan 2006 15:04:05 -0700" // RFC1123 with numeric zone
-			time.RFC3339,     // "2006-01-02T15:04:05Z07:00"
-			time.RFC3339Nano, // "2006-01-02T15:04:05.999999999Z07:00"
-		} {
-			if t, err := time.Parse(dt, date); nil == err {
-				return &t
-			}
+		u, err := strconv.ParseUint(sub[i+1], 10, 0)
+		if nil != err {
+			return Semver{}, fmt.Errorf("invalid version: %s: %v", version, err)
 		}
+		*n = uint(u)
 	}
-	return nil
+	if len(sub) > 4 {
+		v.Prerelease = sub[4]
+	}
+	if len(sub) > 5 {
+		v.Metadata = sub[5]
+	}
+	return v, nil
 }
 
-// String returns a formatted, multi-line string describing Change c.
-func (c *Change) String() string {
-	Parse(c.Version) // validate version string. will panic if invalid.
-
-	const (
-		maxWidth = 80
-		titlePad = 1
-		descPad  = 2
-	)
-
-	runeRepeat := func(c rune, n int) string {
-		b := strings.Builder{}
-		for i := 0; i < n; i++ {
-			b.WriteRune(c)
+// validate returns an error if the given version string is invalid according to
+// the versioning scheme in use (see VersionScheme).
+func validate(version string) error {
+	return globalConfig().Validate(version)
+}
+
+// mustValidate panics if the given version string is invalid according to the
+// versioning scheme in use (see VersionScheme).
+func mustValidate(version string) {
+	if nil != VersionScheme || "" != CalVerFormat {
+		if err := validate(version); nil != err {
+			panic("invalid version: " + err.Error())
 		}
-		return b.String()
+		return
 	}
+	Parse(version)
+}
 
-	// construct the "version - title" left-hand side
-	vsb := strings.Builder{}
-	if "" != c.Package {
-		vsb.WriteString(c.Package)
-		vsb.WriteRune(' ')
+// ParseTolerant parses a semantic version string that may be surrounded by
+// whitespace and prefixed with "v" or "V" (e.g., " v1.2.3\n"), as commonly found
+// in git tags and Go module versions. The canonical form of the version is
+// given by the returned Semver's String method.
+func ParseTolerant(version string) (Semver, error) {
+	s := strings.TrimSpace(version)
+	if strings.HasPrefix(s, "v") || strings.HasPrefix(s, "V") {
+		s = s[1:]
 	}
-	vsb.WriteString("version ")
-	vsb.WriteString(c.Version)
-	if "" != c.Title {
-		vsb.WriteString(" - ")
-		fmt.Fprintf(&vsb, "%q", c.Title)
-	}
-
-	// construct the "date" right-hand side
-	dsb := strings.Builder{}
-	if t := ParseDate(c.Date); nil != t {
-		dsb.WriteString(t.Format(DateTimeFormat))
+	if !IsValid(s) {
+		return Semver{}, fmt.Errorf("invalid version: %q", version)
 	}
+	var v Semver
+	v.Major, v.Minor, v.Patch, v.Prerelease, v.Metadata = Parse(s)
+	return v, nil
+}
 
-	// calculate the padding width between left- and right-hand sides
-	middlePad := maxWidth - ((vsb.Len() + titlePad) + (dsb.Len() + titlePad))
+// Inferred is a set of flags identifying the components of a version string
+// that were omitted and inferred to be zero by ParseLoose.
+type Inferred uint8
 
-	// horizontal line used for containing the header
-	horizLine := runeRepeat('―', maxWidth) + "\n"
+// Constants for each component that ParseLoose may infer.
+const (
+	InferredMinor Inferred = 1 << iota // minor component omitted (e.g., "1")
+	InferredPatch                      // patch component omitted (e.g., "1.2")
+)
 
-	// construct the header containing horizontal lines, version, title, and date
-	b := strings.Builder{}
-	b.WriteString(horizLine)
-	fmt.Fprintf(&b, "%*s%s", titlePad, "", vsb.String())
-	if dsb.Len() > 0 {
-		fmt.Fprintf(&b, "%*s%s", middlePad, "", dsb.String())
+// ParseLoose parses a possibly-truncated semantic version string such as "1" or
+// "1.2-rc.1", in addition to the forms accepted by ParseTolerant. Omitted minor
+// and patch components are set to zero and identified by the returned flags.
+func ParseLoose(version string) (Semver, Inferred, error) {
+	s := strings.TrimSpace(version)
+	if strings.HasPrefix(s, "v") || strings.HasPrefix(s, "V") {
+		s = s[1:]
 	}
-	b.WriteRune('\n')
-	b.WriteString(horizLine)
+	core, rest := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, rest = s[:i], s[i:]
+	}
+	var inf Inferred
+	switch strings.Count(core, ".") {
+	case 0:
+		core += ".0.0"
+		inf = InferredMinor | InferredPatch
+	case 1:
+		core += ".0"
+		inf = InferredPatch
+	}
+	v, err := ParseTolerant(core + rest)
+	if nil != err {
+		return Semver{}, 0, fmt.Errorf("invalid version: %q", version)
+	}
+	return v, inf, nil
+}
 
-	// append each description line with indentation
-	if nil != c.Description {
-		for _, line := range c.Description {
-			fmt.Fprintf(&b, "%*s%s\n", descPad, "", line)
+// coercePattern matches the first run of up to three dot-separated numbers.
+var coercePattern = regexp.MustCompile(`(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// Coerce extracts a best-effort semantic version from an arbitrary string, such
+// as "release-1.4.0-build77" (1.4.0) or "MyApp 2.3" (2.3.0). The first run of up
+// to three dot-separated numbers is used as the major, minor, and patch
+// components, with omitted components set to zero. Prerelease and build
+// metadata are never included.
+// Returns false if no version can be found in s.
+func Coerce(s string) (Semver, bool) {
+	for _, m := range coercePattern.FindAllStringSubmatch(s, -1) {
+		var n [3]uint64
+		var err error
+		for i, c := range m[1:] {
+			if "" != c {
+				if n[i], err = strconv.ParseUint(c, 10, 0); nil != err {
+					break // number too large; keep searching
+				}
+			}
+		}
+		if nil == err {
+			return Semver{Major: uint(n[0]), Minor: uint(n[1]), Patch: uint(n[2])}, true
 		}
 	}
-
-	return b.String()
+	return Semver{}, false
 }
 
-// ChangeLog contains the history of version changes.
-var ChangeLog []Change
-
-// Parse validates a semantic version string and returns each of its components.
-// It panics if the given version string is invalid.
-func Parse(version string) (major, minor, patch uint, pre, meta string) {
-	re := regexp.MustCompile(VersionPattern)
-	sub := re.FindStringSubmatch(version)
-	if 0 == len(sub) {
-		panic("invalid version: " + version)
-	}
-	fmt.Sscanf(sub[1], "%d", &major)
-	fmt.Sscanf(sub[2], "%d", &minor)
-	fmt.Sscanf(sub[3], "%d", &patch)
-	if len(sub) > 4 && "" != sub[4] {
-		pre = sub[4]
-	}
-	if len(sub) > 5 && "" != sub[5] {
-		meta = sub[5]
+// IsValid returns true if and only if the given version string is a valid
+// semantic version.
+func IsValid(version string) bool {
+	if semverPattern == VersionPattern {
+		_, ok := scanSemver(version)
+		return ok
 	}
-	return
+	return mustCompilePattern(VersionPattern).MatchString(version)
 }
 
 // Set sets the package version using a given semantic version string.
 // It panics if the given version string is invalid.
 func Set(version string) {
-	Version.Major, Version.Minor, Version.Patch,
-		Version.Prerelease, Version.Metadata = Parse(version)
+	if err := Default.Set(version); nil != err {
+		panic(err)
+	}
 }
 
 // IsSet returns true if and only if the package version has been set.
 // The package version is considered not-set if all components are equal to
 // their zero value.
 func IsSet() bool {
-	return Version.Major != 0 || Version.Minor != 0 || Version.Patch != 0 ||
-		Version.Prerelease != "" || Version.Metadata != ""
+	return Default.IsSet()
 }
 
 // String returns the semantic version string of the package.
-// If the version has not been set, the last entry in ChangeLog is used (or
-// panics if the last entry in ChangeLog contains an invalid version string).
+// If the version has not been set, the last entry in ChangeLog without a Module
+// is used (or panics if that entry contains an invalid version string).
+// If VersionScheme or CalVerFormat is defined, the version string of that entry
+// is returned as-is.
 // If ChangeLog has also not been set, an empty string is returned.
 func String() string {
-
-	str := func(major uint, minor uint, patch uint, pre string, meta string) string {
-		b := strings.Builder{}
-		fmt.Fprintf(&b, "%d.%d.%d", major, minor, patch)
-		if "" != pre {
-			b.WriteRune('-')
-			b.WriteString(pre)
-		}
-		if "" != meta {
-			b.WriteRune('+')
-			b.WriteString(meta)
-		}
-		return b.String()
+	ver, err := versionString()
+	if nil != err {
+		panic(err.Error())
 	}
+	return ver
+}
 
-	if IsSet() {
-		return str(Version.Major, Version.Minor, Version.Patch,
-			Version.Prerelease, Version.Metadata)
-	} else if nil != ChangeLog && len(ChangeLog) > 0 {
-		return str(Parse(ChangeLog[len(ChangeLog)-1].Version))
-	}
-	return ""
+// versionString returns the semantic version string of the package as
+// described by String, or an error if the last entry in ChangeLog without a
+// Module contains an invalid version string.
+func versionString() (string, error) {
+	return Default.VersionString()
 }
 
-// FprintPackageVersion writes to given io.Writer w a descriptive version string.
-// Includes the package name if defined in ChangeLog.
-// Panics if any of the version components are invalid.
-func FprintPackageVersion(w io.Writer) {
+// format returns the semantic version string composed of the given components.
+func format(major uint, minor uint, patch uint, pre string, meta string) string {
 	b := strings.Builder{}
-	// include package name if defined in the ChangeLog
-	if nil != ChangeLog && len(ChangeLog) > 0 {
-		if pkg := ChangeLog[len(ChangeLog)-1].Package; "" != pkg {
-			b.WriteString(pkg)
-		}
+	fmt.Fprintf(&b, "%d.%d.%d", major, minor, patch)
+	if "" != pre {
+		b.WriteRune('-')
+		b.WriteString(pre)
 	}
-	if ver := String(); "" != ver {
-		if b.Len() > 0 {
-			b.WriteRune(' ')
-		}
-		b.WriteString("version ")
-		b.WriteString(ver)
-	}
-	if b.Len() > 0 {
-		fmt.Fprintf(w, "%s\n", b.String())
+	if "" != meta {
+		b.WriteRune('+')
+		b.WriteString(meta)
 	}
+	return b.String()
+}
+
+// FprintPackageVersion writes to given io.Writer w a descriptive version string.
+// Includes the package name if defined in ChangeLog.
+// Returns an error if any of the version components are invalid or if the
+// string could not be written to w.
+func FprintPackageVersion(w io.Writer) error {
+	return Default.FprintPackageVersion(w)
 }
 
 // PrintPackageVersion writes to stdout a descriptive version string.
 // Includes the package name if defined in ChangeLog.
-// Panics if any of the version components are invalid.
-func PrintPackageVersion() {
-	FprintPackageVersion(os.Stdout)
+// Returns an error if any of the version components are invalid or if the
+// string could not be written.
+func PrintPackageVersion() error {
+	return FprintPackageVersion(os.Stdout)
 }
 
 // FprintChangeLog writes to given io.Writer w all of the entries in ChangeLog.
-// Panics if any of the entries have invalid version strings.
-func FprintChangeLog(w io.Writer) {
-	if nil != ChangeLog {
-		for _, c := range ChangeLog {
-			fmt.Fprintf(w, "%s\n", c.String())
-		}
+// Each entry is streamed to w as it is formatted (see Change.WriteTo).
+// Returns the first error encountered, either an entry with an invalid version
+// string or a failure writing to w.
+func FprintChangeLog(w io.Writer) error {
+	return Default.FprintChangeLog(w)
+}
+
+// Recent returns the last n entries in ChangeLog, or all entries if n is not
+// positive or exceeds the number of entries.
+func Recent(n int) []Change {
+	if n <= 0 || n > len(ChangeLog) {
+		return ChangeLog
 	}
+	return ChangeLog[len(ChangeLog)-n:]
+}
+
+// FprintRecentChanges writes to given io.Writer w the last n entries in
+// ChangeLog (see Recent), as written by FprintChangeLog.
+func FprintRecentChanges(w io.Writer, n int) error {
+	return globalConfig().FprintChangeLog(w, Recent(n))
 }
 
 // PrintChangeLog writes to stdout all of the entries in ChangeLog.
-// Panics if any of the entries have invalid version strings.
-func PrintChangeLog() {
-	FprintChangeLog(os.Stdout)
+// Returns the first error encountered, either an entry with an invalid version
+// string or a failure writing to stdout.
+func PrintChangeLog() error {
+	return FprintChangeLog(os.Stdout)
 }
@@ -0,0 +1,49 @@
+package version
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+// FromFile sets the package version from the single-line VERSION file at the
+// given path, as written by WriteFile. Surrounding whitespace and a leading "v"
+// or "V" are ignored (see ParseTolerant).
+// Returns an error if the file cannot be read or does not contain a valid
+// version string, in which case the package version is unchanged.
+func FromFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if nil != err {
+		return err
+	}
+	if err := FromString(string(data)); nil != err {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// FromString sets the package version from the contents of a VERSION file, such
+// as a string embedded with a "//go:embed VERSION" directive. Surrounding
+// whitespace and a leading "v" or "V" are ignored (see ParseTolerant).
+// Returns an error if the contents are not a valid version string, in which
+// case the package version is unchanged.
+func FromString(s string) error {
+	v, err := ParseTolerant(s)
+	if nil != err {
+		return err
+	}
+	Set(v.String())
+	return nil
+}
+
+// WriteFile writes the given version string to the VERSION file at the given
+// path, in canonical form (without a "v" prefix) followed by a newline, so that
+// it can be read by FromFile. The file is created if it does not exist.
+// Returns an error if the version string is invalid (see ParseTolerant) or the
+// file could not be written.
+func WriteFile(path, version string) error {
+	v, err := ParseTolerant(version)
+	if nil != err {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(v.String()+"\n"), 0644)
+}
@@ -0,0 +1,43 @@
+package version_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestVersionFile(t *testing.T) {
+	defer func(v version.Semver) { version.Version = v }(version.Version)
+	dir, err := ioutil.TempDir("", "versionfile")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "VERSION")
+
+	if err := version.WriteFile(path, "v1.4.2-rc.1"); nil != err {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadFile(path); "1.4.2-rc.1\n" != string(data) {
+		t.Errorf("VERSION = %q, want %q", data, "1.4.2-rc.1\n")
+	}
+	if err := version.FromFile(path); nil != err {
+		t.Fatal(err)
+	}
+	if got := version.String(); "1.4.2-rc.1" != got {
+		t.Errorf("String() = %q, want %q", got, "1.4.2-rc.1")
+	}
+
+	if err := version.FromString("V2.0.0\r\n"); nil != err || "2.0.0" != version.String() {
+		t.Errorf("FromString = %v, String() = %q", err, version.String())
+	}
+	if err := version.FromString("2.0\nextra"); nil == err {
+		t.Error("FromString accepted an invalid version")
+	}
+	if "2.0.0" != version.String() {
+		t.Errorf("invalid VERSION changed String() to %q", version.String())
+	}
+}
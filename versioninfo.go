@@ -0,0 +1,58 @@
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// VersionInfo describes the version of the executable in the form reported by
+// Kubernetes components (e.g., "kubectl version -o json").
+type VersionInfo struct {
+	Major        string `json:"major"`
+	Minor        string `json:"minor"`
+	GitVersion   string `json:"gitVersion"`
+	GitCommit    string `json:"gitCommit"`
+	GitTreeState string `json:"gitTreeState"`
+	BuildDate    string `json:"buildDate"`
+	GoVersion    string `json:"goVersion"`
+	Compiler     string `json:"compiler"`
+	Platform     string `json:"platform"`
+}
+
+// String returns the GitVersion of VersionInfo i.
+func (i VersionInfo) String() string {
+	return i.GitVersion
+}
+
+// CurrentVersionInfo returns the VersionInfo of the executable, composed of the
+// package version (prefixed with "v" as a git tag), Commit, TreeState,
+// BuildDate (formatted as RFC 3339 where recognized), and runtime details.
+// Returns an error if any of the version components are invalid.
+func CurrentVersionInfo() (VersionInfo, error) {
+	ver, err := versionString()
+	if nil != err {
+		return VersionInfo{}, err
+	}
+	info := VersionInfo{
+		GitCommit:    Commit,
+		GitTreeState: TreeState,
+		BuildDate:    BuildDate,
+		GoVersion:    runtime.Version(),
+		Compiler:     runtime.Compiler,
+		Platform:     fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+	if "" != ver {
+		info.GitVersion = TagName(ver)
+	}
+	if nil == VersionScheme && "" == CalVerFormat && "" != ver {
+		major, minor, _, _, _ := Parse(ver)
+		info.Major = strconv.FormatUint(uint64(major), 10)
+		info.Minor = strconv.FormatUint(uint64(minor), 10)
+	}
+	if t := ParseDate(BuildDate); nil != t {
+		info.BuildDate = t.UTC().Format(time.RFC3339)
+	}
+	return info, nil
+}
@@ -0,0 +1,43 @@
+package version_test
+
+import (
+	"encoding/json"
+	"runtime"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCurrentVersionInfo(t *testing.T) {
+	defer func(v version.Semver, commit, date, state string) {
+		version.Version, version.Commit, version.BuildDate, version.TreeState = v, commit, date, state
+	}(version.Version, version.Commit, version.BuildDate, version.TreeState)
+	version.Set("1.22.3-rc.1")
+	version.Commit = "abc1234"
+	version.BuildDate = "2020-03-09 17:45:23"
+	version.TreeState = "clean"
+
+	info, err := version.CurrentVersionInfo()
+	if nil != err {
+		t.Fatal(err)
+	}
+	want := version.VersionInfo{
+		Major:        "1",
+		Minor:        "22",
+		GitVersion:   "v1.22.3-rc.1",
+		GitCommit:    "abc1234",
+		GitTreeState: "clean",
+		BuildDate:    "2020-03-09T17:45:23Z",
+		GoVersion:    runtime.Version(),
+		Compiler:     runtime.Compiler,
+		Platform:     runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if info != want {
+		t.Errorf("CurrentVersionInfo() = %+v, want %+v", info, want)
+	}
+	b, _ := json.Marshal(info)
+	var m map[string]string
+	if err := json.Unmarshal(b, &m); nil != err || "v1.22.3-rc.1" != m["gitVersion"] {
+		t.Errorf("json = %s", b)
+	}
+}
@@ -0,0 +1,138 @@
+package version
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultWatchInterval is the interval at which a Watcher polls its changelog
+// file if no positive interval is given to WatchChangeLog.
+const DefaultWatchInterval = 2 * time.Second
+
+// Watcher reloads a changelog file whenever it is modified, so that
+// long-running services pick up edits without a restart. Each reload replaces
+// the entries returned by ChangeLog atomically; readers never observe a
+// partially-loaded changelog.
+//
+// The package-level ChangeLog is not modified, since it cannot be read and
+// written safely from multiple goroutines. Services should instead read the
+// entries from Watcher.ChangeLog, or serve them with Watcher.ServeHTTP.
+type Watcher struct {
+	path string
+	log  atomic.Value // History
+
+	mu   sync.Mutex
+	err  error     // last reload error
+	mod  time.Time // modification time of the last file loaded
+	size int64     // size of the last file loaded
+
+	done chan struct{}
+	stop sync.Once
+	wg   sync.WaitGroup
+}
+
+// WatchChangeLog loads the changelog file at the given path (see LoadChangeLog)
+// and starts polling it for modifications at the given interval, or at
+// DefaultWatchInterval if interval is not positive. Call Close to stop
+// watching.
+// Returns an error if the file could not be loaded or contains an invalid
+// version string.
+func WatchChangeLog(path string, interval time.Duration) (*Watcher, error) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	w := &Watcher{path: path, done: make(chan struct{})}
+	if err := w.reload(); nil != err {
+		return nil, err
+	}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-t.C:
+				w.poll()
+			}
+		}
+	}()
+	return w, nil
+}
+
+// ChangeLog returns the entries most recently loaded from the watched file.
+// The returned History must not be modified.
+func (w *Watcher) ChangeLog() History {
+	return w.log.Load().(History)
+}
+
+// Err returns the error encountered by the most recent attempt to reload the
+// watched file, or nil if it succeeded. When a reload fails, the previously
+// loaded entries are retained.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Close stops watching the file. The entries most recently loaded remain
+// available from ChangeLog. Close always returns nil.
+func (w *Watcher) Close() error {
+	w.stop.Do(func() { close(w.done) })
+	w.wg.Wait()
+	return nil
+}
+
+// ServeHTTP implements http.Handler, writing the entries most recently loaded
+// from the watched file as a JSON array (see WriteChangeLog).
+func (w *Watcher) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := WriteChangeLog(rw, w.ChangeLog()); nil != err {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// poll reloads the watched file if its modification time or size has changed
+// since it was last loaded.
+func (w *Watcher) poll() {
+	fi, err := os.Stat(w.path)
+	w.mu.Lock()
+	changed := nil != err || !fi.ModTime().Equal(w.mod) || fi.Size() != w.size
+	w.mu.Unlock()
+	if changed {
+		w.reload()
+	}
+}
+
+// reload loads and validates the watched file, replacing the current entries
+// if successful. Returns (and records for Err) any error encountered.
+func (w *Watcher) reload() error {
+	fi, err := os.Stat(w.path)
+	var log []Change
+	if nil == err {
+		log, err = LoadChangeLog(w.path)
+	}
+	for i := 0; nil == err && i < len(log); i++ {
+		if verr := validate(log[i].Version); nil != verr {
+			err = fmt.Errorf("%s: %v", w.path, verr)
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.err = err
+	if nil != fi {
+		// remember the file even if it is invalid, so that it is not reloaded
+		// again until it is modified.
+		w.mod, w.size = fi.ModTime(), fi.Size()
+	}
+	if nil == err {
+		w.log.Store(History(log))
+	}
+	return err
+}
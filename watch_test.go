@@ -0,0 +1,66 @@
+package version_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ardnew/version"
+)
+
+func TestWatchChangeLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "CHANGELOG.json")
+	write := func(s string, mod time.Time) {
+		if err := ioutil.WriteFile(path, []byte(s), 0644); nil != err {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mod, mod); nil != err {
+			t.Fatal(err)
+		}
+	}
+	latest := func(w *version.Watcher) string {
+		c, _ := w.ChangeLog().Latest()
+		return c.Version
+	}
+	wait := func(cond func() bool) bool {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+			if cond() {
+				return true
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		return false
+	}
+
+	now := time.Now()
+	write(`[{"version":"1.0.0"}]`, now)
+	w, err := version.WatchChangeLog(path, time.Millisecond)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if v := latest(w); "1.0.0" != v {
+		t.Fatalf("initial version = %q, want 1.0.0", v)
+	}
+
+	write(`[{"version":"1.0.0"},{"version":"1.1.0"}]`, now.Add(time.Second))
+	if !wait(func() bool { return "1.1.0" == latest(w) }) {
+		t.Fatalf("version = %q after modification, want 1.1.0", latest(w))
+	}
+
+	// an invalid file is reported but does not replace the loaded entries
+	write(`[{"version":"1.02.0"}]`, now.Add(2*time.Second))
+	if !wait(func() bool { return nil != w.Err() }) {
+		t.Fatal("invalid changelog not reported")
+	}
+	if v := latest(w); "1.1.0" != v {
+		t.Errorf("version = %q after invalid modification, want 1.1.0", v)
+	}
+}
@@ -0,0 +1,157 @@
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WindowsVersionInfo is the version resource of a Windows executable, in the
+// versioninfo.json format consumed by goversioninfo
+// (https://github.com/josephspurrier/goversioninfo), from which windres-style
+// resource (.syso) files are generated.
+type WindowsVersionInfo struct {
+	FixedFileInfo  WindowsFixedFileInfo
+	StringFileInfo WindowsStringFileInfo
+	VarFileInfo    WindowsVarFileInfo
+	IconPath       string
+	ManifestPath   string
+}
+
+// WindowsFileVersion is the numeric four-component version of a Windows file.
+// Each component is at most 65535.
+type WindowsFileVersion struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+}
+
+// WindowsFixedFileInfo is the language-independent part of a version resource.
+// Flags and types are hexadecimal strings, as expected by goversioninfo.
+type WindowsFixedFileInfo struct {
+	FileVersion    WindowsFileVersion
+	ProductVersion WindowsFileVersion
+	FileFlagsMask  string
+	FileFlags      string `json:"FileFlags "` // sic; the key used by goversioninfo
+	FileOS         string
+	FileType       string
+	FileSubType    string
+}
+
+// WindowsStringFileInfo is the text shown in the properties of a Windows file.
+type WindowsStringFileInfo struct {
+	Comments         string
+	CompanyName      string
+	FileDescription  string
+	FileVersion      string
+	InternalName     string
+	LegalCopyright   string
+	LegalTrademarks  string
+	OriginalFilename string
+	PrivateBuild     string
+	ProductName      string
+	ProductVersion   string
+	SpecialBuild     string
+}
+
+// WindowsVarFileInfo identifies the language and character set of the strings
+// in a version resource.
+type WindowsVarFileInfo struct {
+	Translation struct {
+		LangID    string
+		CharsetID string
+	}
+}
+
+// Fixed file info values of a version resource (see VS_FIXEDFILEINFO).
+const (
+	winFileFlagsMask  = "3f"     // VS_FFI_FILEFLAGSMASK
+	winFilePrerelease = "02"     // VS_FF_PRERELEASE
+	winFileOS         = "040004" // VOS_NT_WINDOWS32
+	winFileTypeApp    = "01"     // VFT_APP
+)
+
+// CurrentWindowsVersionInfo returns the version resource of the executable,
+// composed of the package version and the package name and title of the last
+// entry in ChangeLog without a Module. The BUILD component of the numeric version is that of a
+// four-component version (see LegacyScheme), or the build metadata of a
+// semantic version if it is a number, or else 0. Prereleases are flagged
+// VS_FF_PRERELEASE. Fields with no source in this package, such as CompanyName
+// and LegalCopyright, are left empty for the caller to define.
+// Returns an error if the package version is invalid, undefined, or has a
+// component greater than 65535.
+func CurrentWindowsVersionInfo() (WindowsVersionInfo, error) {
+	ver, err := versionString()
+	if nil != err {
+		return WindowsVersionInfo{}, err
+	}
+	if "" == ver {
+		return WindowsVersionInfo{}, fmt.Errorf("windows version info: version undefined")
+	}
+	num, err := windowsFileVersion(ver)
+	if nil != err {
+		return WindowsVersionInfo{}, err
+	}
+	var info WindowsVersionInfo
+	info.FixedFileInfo = WindowsFixedFileInfo{
+		FileVersion:    num,
+		ProductVersion: num,
+		FileFlagsMask:  winFileFlagsMask,
+		FileFlags:      "00",
+		FileOS:         winFileOS,
+		FileType:       winFileTypeApp,
+		FileSubType:    "00",
+	}
+	if isPrerelease(ver) {
+		info.FixedFileInfo.FileFlags = winFilePrerelease
+	}
+	info.StringFileInfo.FileVersion = ver
+	info.StringFileInfo.ProductVersion = ver
+	if c := Default.latest(); nil != c {
+		info.StringFileInfo.Comments = c.Title
+		if "" != c.Package {
+			info.StringFileInfo.ProductName = c.Package
+			info.StringFileInfo.InternalName = c.Package
+			info.StringFileInfo.OriginalFilename = c.Package + ".exe"
+		}
+	}
+	info.VarFileInfo.Translation.LangID = "0409"    // U.S. English
+	info.VarFileInfo.Translation.CharsetID = "04B0" // Unicode
+	return info, nil
+}
+
+// windowsFileVersion returns the numeric four-component version of the given
+// valid version string.
+func windowsFileVersion(ver string) (WindowsFileVersion, error) {
+	var n [4]uint
+	if v, err := ParseLegacy(ver); nil == err {
+		n = [4]uint{v.Major, v.Minor, v.Patch, v.Build}
+	} else if v, ok := Coerce(ver); ok {
+		n = [4]uint{v.Major, v.Minor, v.Patch}
+		if s, err := ParseSemver(ver); nil == err && isNumeric(s.Metadata) {
+			b, err := strconv.ParseUint(s.Metadata, 10, 0)
+			if nil != err {
+				return WindowsFileVersion{}, fmt.Errorf("windows version info: build metadata of %q: %v", ver, err)
+			}
+			n[3] = uint(b)
+		}
+	} else {
+		return WindowsFileVersion{}, fmt.Errorf("windows version info: no numeric version in %q", ver)
+	}
+	for _, u := range n {
+		if u > 0xffff {
+			return WindowsFileVersion{}, fmt.Errorf("windows version info: component %d of %q exceeds 65535", u, ver)
+		}
+	}
+	return WindowsFileVersion{Major: int(n[0]), Minor: int(n[1]), Patch: int(n[2]), Build: int(n[3])}, nil
+}
+
+// WriteWindowsVersionInfo encodes to given io.Writer w the given version
+// resource as indented JSON, in the versioninfo.json format.
+func WriteWindowsVersionInfo(w io.Writer, info WindowsVersionInfo) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(info)
+}
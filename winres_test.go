@@ -0,0 +1,49 @@
+package version_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ardnew/version"
+)
+
+func TestCurrentWindowsVersionInfo(t *testing.T) {
+	defer func(v version.Semver, log version.History) {
+		version.Version, version.ChangeLog = v, log
+	}(version.Version, version.ChangeLog)
+	version.Version = version.Semver{}
+	version.ChangeLog = version.History{
+		{Package: "tool", Version: "1.2.3-rc.1+42", Title: "Candidate"},
+	}
+
+	info, err := version.CurrentWindowsVersionInfo()
+	if nil != err {
+		t.Fatal(err)
+	}
+	want := version.WindowsFileVersion{Major: 1, Minor: 2, Patch: 3, Build: 42}
+	if info.FixedFileInfo.FileVersion != want || info.FixedFileInfo.ProductVersion != want {
+		t.Errorf("FileVersion = %+v, want %+v", info.FixedFileInfo.FileVersion, want)
+	}
+	if "02" != info.FixedFileInfo.FileFlags {
+		t.Errorf("FileFlags = %q, want prerelease", info.FixedFileInfo.FileFlags)
+	}
+	s := info.StringFileInfo
+	if "1.2.3-rc.1+42" != s.ProductVersion || "tool.exe" != s.OriginalFilename || "Candidate" != s.Comments {
+		t.Errorf("StringFileInfo = %+v", s)
+	}
+
+	var b strings.Builder
+	if err := version.WriteWindowsVersionInfo(&b, info); nil != err {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), `"FileFlags ": "02"`) {
+		t.Errorf("WriteWindowsVersionInfo() = %s", b.String())
+	}
+
+	for _, v := range []string{"70000.0.0", "1.2.3+70000", "1.2.3+99999999999999999999"} {
+		version.ChangeLog[0].Version = v
+		if _, err := version.CurrentWindowsVersionInfo(); nil == err {
+			t.Errorf("CurrentWindowsVersionInfo(%s): expected error", v)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package version
+
+// IsYanked returns true if and only if an entry in ChangeLog with the given
+// version is marked Yanked. Versions are matched by precedence, so build
+// metadata is ignored.
+// It panics if any of the version strings are invalid.
+func IsYanked(version string) bool {
+	for _, c := range ChangeLog {
+		if c.Yanked && 0 == compareVersions(c.Version, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Yanked returns each entry in ChangeLog that is marked Yanked, in ChangeLog
+// order.
+func Yanked() []Change {
+	var changes []Change
+	for _, c := range ChangeLog {
+		if c.Yanked {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
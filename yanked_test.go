@@ -0,0 +1,29 @@
+package version_test
+
+import (
+	"fmt"
+
+	"github.com/ardnew/version"
+)
+
+func ExampleIsYanked() {
+	log := version.ChangeLog
+	defer func() { version.ChangeLog = log }()
+
+	version.ChangeLog = []version.Change{
+		{Version: "1.0.0"},
+		{Version: "1.0.1", Yanked: true, Description: []string{"corrupts data"}},
+		{Version: "1.0.2"},
+	}
+	fmt.Println(version.IsYanked("1.0.1+build.7"), version.IsYanked("1.0.2"))
+	for _, c := range version.Yanked() {
+		fmt.Print(c.Layout(version.RenderOptions{Width: 30, Rule: '=', Margin: 1, Indent: 2}))
+	}
+
+	// Output:
+	// true false
+	// ==============================
+	//  version 1.0.1 [YANKED]
+	// ==============================
+	//   corrupts data
+}